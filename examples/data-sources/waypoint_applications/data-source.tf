@@ -0,0 +1,10 @@
+data "waypoint_applications" "example" {
+  project_name = "example"
+}
+
+output "deployment_urls" {
+  value = {
+    for app in data.waypoint_applications.example.applications :
+    app.app_name => app.latest_deployment.url if app.latest_deployment != null
+  }
+}
@@ -0,0 +1,8 @@
+data "waypoint_latest_build" "example" {
+  project_name = "example"
+  app_name     = "example-app"
+}
+
+output "artifact" {
+  value = jsondecode(data.waypoint_latest_build.example.artifact_json)
+}
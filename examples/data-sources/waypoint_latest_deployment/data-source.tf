@@ -0,0 +1,9 @@
+data "waypoint_latest_deployment" "example" {
+  project_name = "example"
+  app_name     = "example-app"
+  workspace    = "production"
+}
+
+output "deployment_url" {
+  value = data.waypoint_latest_deployment.example.url
+}
@@ -0,0 +1,8 @@
+data "waypoint_latest_release" "example" {
+  project_name = "example"
+  app_name     = "example-app"
+}
+
+output "release_url" {
+  value = data.waypoint_latest_release.example.url
+}
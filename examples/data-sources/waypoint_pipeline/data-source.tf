@@ -0,0 +1,8 @@
+data "waypoint_pipeline" "release" {
+  project = "payments"
+  name    = "release"
+}
+
+output "release_pipeline_healthy" {
+  value = try(data.waypoint_pipeline.release.last_run.state, null) == "SUCCESS"
+}
@@ -0,0 +1,7 @@
+data "waypoint_pipelines" "payments" {
+  project = "payments"
+}
+
+output "pipeline_names" {
+  value = data.waypoint_pipelines.payments.pipelines[*].name
+}
@@ -0,0 +1,8 @@
+data "waypoint_projects" "team" {
+  name_prefix            = "team-"
+  remote_runners_enabled = true
+}
+
+output "team_project_names" {
+  value = data.waypoint_projects.team.projects[*].project_name
+}
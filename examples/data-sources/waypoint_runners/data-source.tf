@@ -0,0 +1,12 @@
+data "waypoint_runners" "payments" {
+  labels = {
+    app = "payments"
+  }
+}
+
+resource "waypoint_runner_profile" "payments" {
+  profile_name     = "payments"
+  oci_url          = "hashicorp/waypoint-odr:latest"
+  plugin_type      = "docker"
+  target_runner_id = data.waypoint_runners.payments.runners[0].id
+}
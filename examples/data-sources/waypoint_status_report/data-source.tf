@@ -0,0 +1,16 @@
+data "waypoint_status_report" "example" {
+  project_name = "example"
+  app_name     = "example-app"
+  target       = "deployment"
+}
+
+resource "terraform_data" "promote" {
+  input = data.waypoint_status_report.example.deployment_id
+
+  lifecycle {
+    precondition {
+      condition     = data.waypoint_status_report.example.health == "READY"
+      error_message = "The latest deployment is not healthy: ${data.waypoint_status_report.example.health_message}"
+    }
+  }
+}
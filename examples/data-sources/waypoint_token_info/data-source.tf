@@ -0,0 +1,11 @@
+data "waypoint_token_info" "current" {
+  warn_before = "168h"
+}
+
+output "token_user" {
+  value = data.waypoint_token_info.current.username
+}
+
+output "token_expires_at" {
+  value = data.waypoint_token_info.current.expires_at
+}
@@ -0,0 +1,7 @@
+data "waypoint_user" "rob" {
+  username = "devopsrob"
+}
+
+output "rob_email" {
+  value = data.waypoint_user.rob.email
+}
@@ -0,0 +1,5 @@
+data "waypoint_users" "all" {}
+
+output "usernames" {
+  value = data.waypoint_users.all.users[*].username
+}
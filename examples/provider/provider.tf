@@ -20,4 +20,7 @@ provider "waypoint" {
   # output from `waypoint user token`, 
   # or use WAYPOINT_TOKEN environment variable 
   # token = ""
+
+  # warn when the token expires within a week
+  # token_expiry_warning = "168h"
 }
\ No newline at end of file
@@ -3,33 +3,34 @@ resource "waypoint_project" "example" {
   remote_runners_enabled = true
 
   data_source_git = {
-    git_url                   = "https://github.com/hashicorp/waypoint-examples"
-    git_path                  = "docker/go"
-    git_ref                   = "HEAD"
-    file_change_signal        = "some-signal"
-    git_poll_interval_seconds = 15
+    git_url            = "https://github.com/hashicorp/waypoint-examples"
+    git_path           = "docker/go"
+    git_ref            = "HEAD"
+    file_change_signal = "some-signal"
+    git_poll_interval  = "15s"
+    recurse_submodules = 1
     # ignore_changes_outside_path = true
   }
 
-  app_status_poll_seconds = 12
+  app_status_poll_interval = "12s"
 
-  project_variables = [
-    {
-      name      = "name"
-      value     = "devopsrob"
-      sensitive = true
-    },
-    {
-      name      = "job"
+  # Register the applications in waypoint.hcl during apply. Needs a runner.
+  # run_init_on_create = true
+  # init_timeout       = "10m"
+
+  variables = {
+    name = {
+      sensitive_value = "devopsrob"
+    }
+    job = {
       value     = "dev-advocate"
       sensitive = false
-    },
-    {
-      name      = "conference"
+    }
+    conference = {
       value     = "HashiConf EU 2022"
       sensitive = false
-    },
-  ]
+    }
+  }
 
   git_auth_basic = {
     username = "catsby"
@@ -43,23 +44,24 @@ resource "waypoint_project" "example1" {
   project_name           = "example1"
   remote_runners_enabled = true
 
+  # Changes are pushed by webhook, so git polling is turned off
   data_source_git = {
-    git_url                   = "https://github.com/hashicorp/waypoint-examples"
-    git_path                  = "docker/go"
-    git_ref                   = "HEAD"
-    file_change_signal        = "some-signal"
-    git_poll_interval_seconds = 15
+    git_url             = "git@github.com:hashicorp/waypoint-examples.git"
+    git_path            = "docker/go"
+    git_ref             = "HEAD"
+    file_change_signal  = "some-signal"
+    git_poll_interval   = "15s"
+    git_polling_enabled = false
   }
 
-  app_status_poll_seconds = 12
+  app_status_poll_interval = "12s"
 
-  project_variables = [
-    {
-      name      = "devopsrob"
+  variables = {
+    devopsrob = {
       value     = "dev-advocate"
       sensitive = "false"
-    },
-  ]
+    }
+  }
 
   git_auth_ssh = {
     git_user        = "cassie"
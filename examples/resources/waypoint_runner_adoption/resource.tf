@@ -0,0 +1,18 @@
+## Example adopting a runner by ID
+resource "waypoint_runner_adoption" "example" {
+  runner_id = "01G5GNJEYC7RVJNXFGMHD0HCDT"
+}
+
+## Example adopting a runner provisioned in the same apply
+resource "waypoint_runner_adoption" "payments" {
+  runner_labels = {
+    app = "payments"
+  }
+  wait_timeout = "5m"
+}
+
+## Example rejecting a runner
+resource "waypoint_runner_adoption" "rejected" {
+  runner_id = "01G5GNJEYC7RVJNXFGMHD0HCDU"
+  adopt     = false
+}
@@ -0,0 +1,16 @@
+resource "waypoint_runner_token" "payments" {
+  labels = {
+    app = "payments"
+  }
+  ttl = "24h"
+}
+
+resource "kubernetes_secret" "runner_token" {
+  metadata {
+    name = "waypoint-runner-token"
+  }
+
+  data = {
+    token = waypoint_runner_token.payments.token
+  }
+}
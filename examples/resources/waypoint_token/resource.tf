@@ -0,0 +1,19 @@
+## Example login token for a CI system, renewed a week before it expires
+resource "waypoint_token" "ci" {
+  type         = "login"
+  ttl          = "720h"
+  renew_before = "168h"
+}
+
+## Example token that can only run triggers
+resource "waypoint_token" "deploy_hook" {
+  type    = "login"
+  trigger = true
+}
+
+## Example invite for a new user
+resource "waypoint_token" "invite" {
+  type     = "invite"
+  username = "devopsrob"
+  ttl      = "48h"
+}
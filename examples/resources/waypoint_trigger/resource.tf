@@ -0,0 +1,13 @@
+resource "waypoint_trigger" "deploy_staging" {
+  name        = "payments-api-staging"
+  description = "Deploys the payments API to staging from CI"
+  operation   = "up"
+  project     = "payments"
+  application = "api"
+  workspace   = "staging"
+  tags        = ["ci", "staging"]
+}
+
+output "trigger_url" {
+  value = waypoint_trigger.deploy_staging.url
+}
@@ -0,0 +1,5 @@
+resource "waypoint_user_profile" "rob" {
+  username     = "devopsrob"
+  display_name = "Rob Barnes"
+  email        = "rob@example.com"
+}
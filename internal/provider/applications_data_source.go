@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sort"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &applicationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &applicationsDataSource{}
+)
+
+// NewApplicationsDataSource is a helper function to simplify the provider implementation.
+func NewApplicationsDataSource() datasource.DataSource {
+	return &applicationsDataSource{}
+}
+
+// applicationsDataSource is the data source implementation.
+type applicationsDataSource struct {
+	client waypointClient.Waypoint
+}
+
+// applicationsDataSourceModel maps the schema data.
+type applicationsDataSourceModel struct {
+	Project   types.String `tfsdk:"project_name"`
+	Workspace types.String `tfsdk:"workspace"`
+
+	Applications []applicationsItemModel `tfsdk:"applications"`
+}
+
+// applicationsItemModel maps a single application in the list.
+type applicationsItemModel struct {
+	Name             types.String           `tfsdk:"app_name"`
+	Project          types.String           `tfsdk:"project_name"`
+	FileChangeSignal types.String           `tfsdk:"file_change_signal"`
+	LatestDeployment *operationSummaryModel `tfsdk:"latest_deployment"`
+	LatestRelease    *operationSummaryModel `tfsdk:"latest_release"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *applicationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(waypointClient.Waypoint)
+}
+
+// Metadata returns the data source type name.
+func (d *applicationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_applications"
+}
+
+// Schema defines the schema for the data source
+func (d *applicationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Waypoint applications with a summary of their latest deployment and release",
+		Attributes: map[string]schema.Attribute{
+			"project_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the applications of this project. Applications of all projects are listed when unset",
+			},
+			"workspace": schema.StringAttribute{
+				Optional:    true,
+				Description: "Workspace to summarize deployments and releases for. Defaults to `default`",
+			},
+			"applications": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The applications, ordered by project and application name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"app_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Waypoint application",
+						},
+						"project_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the project the application belongs to",
+						},
+						"file_change_signal": schema.StringAttribute{
+							Computed:    true,
+							Description: "Signal sent to the application when its config files change",
+						},
+						"latest_deployment": operationSummaryAttribute("The latest successful deployment that has not been destroyed, if any"),
+						"latest_release":    operationSummaryAttribute("The latest successful release, if any"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state applicationsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := workspaceOrDefault(state.Workspace)
+	ctx = tflog.SetField(ctx, "waypoint_workspace", workspace)
+
+	var projectNames []string
+	if !state.Project.IsNull() {
+		projectNames = []string{state.Project.ValueString()}
+	} else {
+		refs, err := listProjects(ctx, d.client.GRPCClient())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Projects",
+				"Could not list projects: "+err.Error(),
+			)
+			return
+		}
+		for _, ref := range refs {
+			projectNames = append(projectNames, ref.GetProject())
+		}
+	}
+	sort.Strings(projectNames)
+
+	type projectApp struct {
+		project string
+		app     *gen.Application
+	}
+	var apps []projectApp
+	for _, name := range projectNames {
+		project, err := d.client.GetProject(ctx, name)
+		if err != nil {
+			// Projects destroyed after they were listed are skipped, but a
+			// configured project must exist.
+			if status.Code(err) == codes.NotFound && state.Project.IsNull() {
+				continue
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Reading Project",
+				"Could not read Project with name "+name+": "+err.Error(),
+			)
+			return
+		}
+
+		projectApps := project.GetApplications()
+		sort.Slice(projectApps, func(i, j int) bool { return projectApps[i].Name < projectApps[j].Name })
+		for _, app := range projectApps {
+			apps = append(apps, projectApp{project: project.Name, app: app})
+		}
+	}
+
+	state.Applications = []applicationsItemModel{}
+	for _, pa := range apps {
+		projectName, app := pa.project, pa.app
+
+		deployment, err := latestDeployment(ctx, d.client.GRPCClient(), projectName, app.Name, workspace)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Deployments",
+				"Could not read the latest deployment of "+projectName+"/"+app.Name+": "+err.Error(),
+			)
+			return
+		}
+
+		release, err := latestRelease(ctx, d.client.GRPCClient(), projectName, app.Name, workspace)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Releases",
+				"Could not read the latest release of "+projectName+"/"+app.Name+": "+err.Error(),
+			)
+			return
+		}
+
+		state.Applications = append(state.Applications, applicationsItemModel{
+			Name:             types.StringValue(app.Name),
+			Project:          types.StringValue(projectName),
+			FileChangeSignal: types.StringValue(app.FileChangeSignal),
+			LatestDeployment: flattenDeploymentSummary(deployment),
+			LatestRelease:    flattenReleaseSummary(release),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
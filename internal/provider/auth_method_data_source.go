@@ -153,58 +153,21 @@ func (d *authMethodDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	state.Description = types.StringValue(auth.GetDescription())
 	state.AccessorSelector = types.StringValue(auth.GetAccessSelector())
 	state.AccessorSelector = types.StringValue(auth.GetAccessSelector())
-	method := auth.GetOidc()
-	state.ClientID = types.StringValue(method.GetClientId())
-	state.DiscoveryURL = types.StringValue(method.GetDiscoveryUrl())
 
-	allowedRedirectURIs, diags := types.ListValueFrom(ctx, types.StringType, method.GetAllowedRedirectUris())
+	method, diags := flattenAuthMethod(ctx, auth)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.AllowedRedirectURIs = allowedRedirectURIs
-
-	claims, diags := types.MapValueFrom(ctx, types.StringType, method.GetClaimMappings())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	state.ClaimMappings = claims
-
-	listClaims, diags := types.MapValueFrom(ctx, types.StringType, method.GetListClaimMappings())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	state.ListClaimMappings = listClaims
-
-	discoveryCAPEM, diags := types.ListValueFrom(ctx, types.StringType, method.GetDiscoveryCaPem())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	state.DiscoveryCAPEM = discoveryCAPEM
-
-	signingAlgs, diags := types.ListValueFrom(ctx, types.StringType, method.GetSigningAlgs())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	state.SigningAlgs = signingAlgs
-
-	scopes, diags := types.ListValueFrom(ctx, types.StringType, method.GetScopes())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	state.Scopes = scopes
-
-	auds, diags := types.ListValueFrom(ctx, types.StringType, method.GetAuds())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	state.Auds = auds
+	state.ClientID = method.ClientID
+	state.DiscoveryURL = method.DiscoveryURL
+	state.AllowedRedirectURIs = method.AllowedRedirectURIs
+	state.ClaimMappings = method.ClaimMappings
+	state.ListClaimMappings = method.ListClaimMappings
+	state.DiscoveryCAPEM = method.DiscoveryCAPEM
+	state.SigningAlgs = method.SigningAlgs
+	state.Scopes = method.Scopes
+	state.Auds = method.Auds
 
 	// Set state
 	diags = resp.State.Set(ctx, &state)
@@ -5,13 +5,17 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-waypoint/internal/defaults"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -62,6 +66,7 @@ type authMethodResourceModel struct {
 	SigningAlgs         types.List   `tfsdk:"signing_algs"`
 	Scopes              types.List   `tfsdk:"scopes"`
 	Auds                types.List   `tfsdk:"auds"`
+	DeletionProtection  types.Bool   `tfsdk:"deletion_protection"`
 }
 
 // Schema defines the schema for the resource.
@@ -132,6 +137,14 @@ func (r *authMethodResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "The optional audience claims required",
 				ElementType: types.StringType,
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					defaults.BoolDefaultValue(types.BoolValue(false)),
+				},
+				Description: "Prevent Terraform from destroying the auth method, which could lock users out of Waypoint. Must be set to false and applied before the auth method can be destroyed",
+			},
 		},
 	}
 }
@@ -299,58 +312,26 @@ func (r *authMethodResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	auth := getAuthResponse.AuthMethod
 	state.Name = types.StringValue(auth.GetName())
-	method := auth.GetOidc()
-	state.ClientID = types.StringValue(method.GetClientId())
-	state.DiscoveryURL = types.StringValue(method.GetDiscoveryUrl())
-
-	allowedRedirectURIs, diags := types.ListValueFrom(ctx, types.StringType, method.GetAllowedRedirectUris())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	state.AllowedRedirectURIs = allowedRedirectURIs
-
-	claims, diags := types.MapValueFrom(ctx, types.StringType, method.GetClaimMappings())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	state.ClaimMappings = claims
-
-	listClaims, diags := types.MapValueFrom(ctx, types.StringType, method.GetListClaimMappings())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	state.ListClaimMappings = listClaims
-
-	discoveryCAPEM, diags := types.ListValueFrom(ctx, types.StringType, method.GetDiscoveryCaPem())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	state.DiscoveryCAPEM = discoveryCAPEM
 
-	signingAlgs, diags := types.ListValueFrom(ctx, types.StringType, method.GetSigningAlgs())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	// deletion_protection only exists in Terraform state
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
 	}
-	state.SigningAlgs = signingAlgs
 
-	scopes, diags := types.ListValueFrom(ctx, types.StringType, method.GetScopes())
+	method, diags := flattenAuthMethod(ctx, auth)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.Scopes = scopes
-
-	auds, diags := types.ListValueFrom(ctx, types.StringType, method.GetAuds())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	state.Auds = auds
+	state.ClientID = method.ClientID
+	state.DiscoveryURL = method.DiscoveryURL
+	state.AllowedRedirectURIs = method.AllowedRedirectURIs
+	state.ClaimMappings = method.ClaimMappings
+	state.ListClaimMappings = method.ListClaimMappings
+	state.DiscoveryCAPEM = method.DiscoveryCAPEM
+	state.SigningAlgs = method.SigningAlgs
+	state.Scopes = method.Scopes
+	state.Auds = method.Auds
 
 	// Set state
 	diags = resp.State.Set(ctx, &state)
@@ -373,6 +354,15 @@ func (r *authMethodResource) Delete(ctx context.Context, req resource.DeleteRequ
 	authMethodName := state.Name.ValueString()
 	ctx = tflog.SetField(ctx, "waypoint_auth_method", authMethodName)
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Error Deleting Waypoint Auth Method",
+			"Auth Method "+authMethodName+" has deletion_protection enabled. "+
+				"Set deletion_protection to false and apply the change before destroying it.",
+		)
+		return
+	}
+
 	// Delete existing auth method
 	err := r.client.DeleteOidc(ctx, authMethodName)
 	if err != nil {
@@ -383,3 +373,60 @@ func (r *authMethodResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 }
+
+// authMethodOIDCModel holds the OIDC settings of an auth method, which the
+// resource and data source models both include.
+type authMethodOIDCModel struct {
+	ClientID            types.String
+	DiscoveryURL        types.String
+	AllowedRedirectURIs types.List
+	ClaimMappings       types.Map
+	ListClaimMappings   types.Map
+	DiscoveryCAPEM      types.List
+	SigningAlgs         types.List
+	Scopes              types.List
+	Auds                types.List
+}
+
+// flattenAuthMethod converts the method settings of an auth method to their
+// model.
+func flattenAuthMethod(ctx context.Context, auth *gen.AuthMethod) (authMethodOIDCModel, diag.Diagnostics) {
+	var model authMethodOIDCModel
+	var diags diag.Diagnostics
+
+	// The auth method kind is a oneof on the server. OIDC is the only kind the
+	// Waypoint API defines at the time of writing, so anything else is reported
+	// rather than read back as an empty OIDC configuration.
+	var method *gen.AuthMethod_OIDC
+	switch m := auth.GetMethod().(type) {
+	case *gen.AuthMethod_Oidc:
+		method = m.Oidc
+	default:
+		diags.AddError(
+			"Unsupported Auth Method Type",
+			fmt.Sprintf("Auth Method %s uses a method type (%T) that is not supported by this provider.", auth.GetName(), m),
+		)
+		return model, diags
+	}
+
+	model.ClientID = types.StringValue(method.GetClientId())
+	model.DiscoveryURL = types.StringValue(method.GetDiscoveryUrl())
+
+	var d diag.Diagnostics
+	model.AllowedRedirectURIs, d = types.ListValueFrom(ctx, types.StringType, method.GetAllowedRedirectUris())
+	diags.Append(d...)
+	model.ClaimMappings, d = types.MapValueFrom(ctx, types.StringType, method.GetClaimMappings())
+	diags.Append(d...)
+	model.ListClaimMappings, d = types.MapValueFrom(ctx, types.StringType, method.GetListClaimMappings())
+	diags.Append(d...)
+	model.DiscoveryCAPEM, d = types.ListValueFrom(ctx, types.StringType, method.GetDiscoveryCaPem())
+	diags.Append(d...)
+	model.SigningAlgs, d = types.ListValueFrom(ctx, types.StringType, method.GetSigningAlgs())
+	diags.Append(d...)
+	model.Scopes, d = types.ListValueFrom(ctx, types.StringType, method.GetScopes())
+	diags.Append(d...)
+	model.Auds, d = types.ListValueFrom(ctx, types.StringType, method.GetAuds())
+	diags.Append(d...)
+
+	return model, diags
+}
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+)
+
+func TestFlattenAuthMethod(t *testing.T) {
+	method, diags := flattenAuthMethod(context.Background(), &gen.AuthMethod{
+		Name: "google",
+		Method: &gen.AuthMethod_Oidc{Oidc: &gen.AuthMethod_OIDC{
+			ClientId: "client",
+			Scopes:   []string{"email"},
+		}},
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if method.ClientID.ValueString() != "client" || len(method.Scopes.Elements()) != 1 {
+		t.Errorf("unexpected OIDC settings %+v", method)
+	}
+
+	_, diags = flattenAuthMethod(context.Background(), &gen.AuthMethod{Name: "unknown"})
+	if !diags.HasError() {
+		t.Error("expected an error for an auth method without a supported kind")
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &latestBuildDataSource{}
+	_ datasource.DataSourceWithConfigure = &latestBuildDataSource{}
+)
+
+// NewLatestBuildDataSource is a helper function to simplify the provider implementation.
+func NewLatestBuildDataSource() datasource.DataSource {
+	return &latestBuildDataSource{}
+}
+
+// latestBuildDataSource is the data source implementation.
+type latestBuildDataSource struct {
+	client waypointClient.Waypoint
+}
+
+// latestBuildDataSourceModel maps the schema data.
+type latestBuildDataSourceModel struct {
+	Project      types.String `tfsdk:"project_name"`
+	App          types.String `tfsdk:"app_name"`
+	Workspace    types.String `tfsdk:"workspace"`
+	ID           types.String `tfsdk:"id"`
+	Sequence     types.Int64  `tfsdk:"sequence"`
+	Status       types.String `tfsdk:"status"`
+	Component    types.String `tfsdk:"component"`
+	Labels       types.Map    `tfsdk:"labels"`
+	JobID        types.String `tfsdk:"job_id"`
+	ArtifactJSON types.String `tfsdk:"artifact_json"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *latestBuildDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(waypointClient.Waypoint)
+}
+
+// Metadata returns the data source type name.
+func (d *latestBuildDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_latest_build"
+}
+
+// Schema defines the schema for the data source
+func (d *latestBuildDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := latestOperationAttributes("build")
+	attributes["artifact_json"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "JSON encoding of the artifact the build produced, such as the image name and tag for a docker build",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up the latest successful build of an application",
+		Attributes:  attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *latestBuildDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state latestBuildDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := state.Project.ValueString()
+	appName := state.App.ValueString()
+	workspace := workspaceOrDefault(state.Workspace)
+	ctx = tflog.SetField(ctx, "waypoint_project", projectName)
+	ctx = tflog.SetField(ctx, "waypoint_app", appName)
+	ctx = tflog.SetField(ctx, "waypoint_workspace", workspace)
+
+	build, err := latestBuild(ctx, d.client.GRPCClient(), projectName, appName, workspace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Builds",
+			"Could not read the latest build of "+projectName+"/"+appName+": "+err.Error(),
+		)
+		return
+	}
+	if build == nil {
+		resp.Diagnostics.AddError(
+			"No Build Found",
+			"Application "+projectName+"/"+appName+" has no successful build in workspace "+workspace+".",
+		)
+		return
+	}
+
+	state.ID = types.StringValue(build.Id)
+	state.Sequence = types.Int64Value(int64(build.Sequence))
+	state.Status = types.StringValue(build.GetStatus().GetState().String())
+	state.Component = types.StringValue(build.GetComponent().GetName())
+	state.JobID = types.StringValue(build.JobId)
+	state.ArtifactJSON = types.StringValue(build.GetArtifact().GetArtifactJson())
+
+	state.Labels, diags = types.MapValueFrom(ctx, types.StringType, build.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
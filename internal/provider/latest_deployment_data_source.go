@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &latestDeploymentDataSource{}
+	_ datasource.DataSourceWithConfigure = &latestDeploymentDataSource{}
+)
+
+// NewLatestDeploymentDataSource is a helper function to simplify the provider implementation.
+func NewLatestDeploymentDataSource() datasource.DataSource {
+	return &latestDeploymentDataSource{}
+}
+
+// latestDeploymentDataSource is the data source implementation.
+type latestDeploymentDataSource struct {
+	client waypointClient.Waypoint
+}
+
+// latestDeploymentDataSourceModel maps the schema data.
+type latestDeploymentDataSourceModel struct {
+	Project    types.String `tfsdk:"project_name"`
+	App        types.String `tfsdk:"app_name"`
+	Workspace  types.String `tfsdk:"workspace"`
+	ID         types.String `tfsdk:"id"`
+	Sequence   types.Int64  `tfsdk:"sequence"`
+	Status     types.String `tfsdk:"status"`
+	Component  types.String `tfsdk:"component"`
+	Labels     types.Map    `tfsdk:"labels"`
+	JobID      types.String `tfsdk:"job_id"`
+	State      types.String `tfsdk:"state"`
+	URL        types.String `tfsdk:"url"`
+	ArtifactID types.String `tfsdk:"artifact_id"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *latestDeploymentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(waypointClient.Waypoint)
+}
+
+// Metadata returns the data source type name.
+func (d *latestDeploymentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_latest_deployment"
+}
+
+// Schema defines the schema for the data source
+func (d *latestDeploymentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := latestOperationAttributes("deployment")
+	attributes["state"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Physical state of the deployment, one of `UNKNOWN`, `PENDING`, `CREATED` or `DESTROYED`",
+	}
+	attributes["url"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "URL of the deployment, if the platform provides one",
+	}
+	attributes["artifact_id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "ID of the pushed artifact that was deployed",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up the latest successful deployment of an application that has not been destroyed",
+		Attributes:  attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *latestDeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state latestDeploymentDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := state.Project.ValueString()
+	appName := state.App.ValueString()
+	workspace := workspaceOrDefault(state.Workspace)
+	ctx = tflog.SetField(ctx, "waypoint_project", projectName)
+	ctx = tflog.SetField(ctx, "waypoint_app", appName)
+	ctx = tflog.SetField(ctx, "waypoint_workspace", workspace)
+
+	deployment, err := latestDeployment(ctx, d.client.GRPCClient(), projectName, appName, workspace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Deployments",
+			"Could not read the latest deployment of "+projectName+"/"+appName+": "+err.Error(),
+		)
+		return
+	}
+	if deployment == nil {
+		resp.Diagnostics.AddError(
+			"No Deployment Found",
+			"Application "+projectName+"/"+appName+" has no successful deployment in workspace "+workspace+".",
+		)
+		return
+	}
+
+	state.ID = types.StringValue(deployment.Id)
+	state.Sequence = types.Int64Value(int64(deployment.Sequence))
+	state.Status = types.StringValue(deployment.GetStatus().GetState().String())
+	state.Component = types.StringValue(deployment.GetComponent().GetName())
+	state.JobID = types.StringValue(deployment.JobId)
+	state.State = types.StringValue(deployment.State.String())
+	state.URL = types.StringValue(deployment.Url)
+	state.ArtifactID = types.StringValue(deployment.ArtifactId)
+
+	state.Labels, diags = types.MapValueFrom(ctx, types.StringType, deployment.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
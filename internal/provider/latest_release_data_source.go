@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &latestReleaseDataSource{}
+	_ datasource.DataSourceWithConfigure = &latestReleaseDataSource{}
+)
+
+// NewLatestReleaseDataSource is a helper function to simplify the provider implementation.
+func NewLatestReleaseDataSource() datasource.DataSource {
+	return &latestReleaseDataSource{}
+}
+
+// latestReleaseDataSource is the data source implementation.
+type latestReleaseDataSource struct {
+	client waypointClient.Waypoint
+}
+
+// latestReleaseDataSourceModel maps the schema data.
+type latestReleaseDataSourceModel struct {
+	Project      types.String `tfsdk:"project_name"`
+	App          types.String `tfsdk:"app_name"`
+	Workspace    types.String `tfsdk:"workspace"`
+	ID           types.String `tfsdk:"id"`
+	Sequence     types.Int64  `tfsdk:"sequence"`
+	Status       types.String `tfsdk:"status"`
+	Component    types.String `tfsdk:"component"`
+	Labels       types.Map    `tfsdk:"labels"`
+	JobID        types.String `tfsdk:"job_id"`
+	State        types.String `tfsdk:"state"`
+	URL          types.String `tfsdk:"url"`
+	DeploymentID types.String `tfsdk:"deployment_id"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *latestReleaseDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(waypointClient.Waypoint)
+}
+
+// Metadata returns the data source type name.
+func (d *latestReleaseDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_latest_release"
+}
+
+// Schema defines the schema for the data source
+func (d *latestReleaseDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := latestOperationAttributes("release")
+	attributes["state"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Physical state of the release, one of `UNKNOWN`, `PENDING`, `CREATED` or `DESTROYED`",
+	}
+	attributes["url"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "URL the release is served at, if the release manager provides one",
+	}
+	attributes["deployment_id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "ID of the deployment that was released",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up the latest successful release of an application",
+		Attributes:  attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *latestReleaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state latestReleaseDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := state.Project.ValueString()
+	appName := state.App.ValueString()
+	workspace := workspaceOrDefault(state.Workspace)
+	ctx = tflog.SetField(ctx, "waypoint_project", projectName)
+	ctx = tflog.SetField(ctx, "waypoint_app", appName)
+	ctx = tflog.SetField(ctx, "waypoint_workspace", workspace)
+
+	release, err := latestRelease(ctx, d.client.GRPCClient(), projectName, appName, workspace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Releases",
+			"Could not read the latest release of "+projectName+"/"+appName+": "+err.Error(),
+		)
+		return
+	}
+	if release == nil {
+		resp.Diagnostics.AddError(
+			"No Release Found",
+			"Application "+projectName+"/"+appName+" has no successful release in workspace "+workspace+".",
+		)
+		return
+	}
+
+	state.ID = types.StringValue(release.Id)
+	state.Sequence = types.Int64Value(int64(release.Sequence))
+	state.Status = types.StringValue(release.GetStatus().GetState().String())
+	state.Component = types.StringValue(release.GetComponent().GetName())
+	state.JobID = types.StringValue(release.JobId)
+	state.State = types.StringValue(release.State.String())
+	state.URL = types.StringValue(release.Url)
+	state.DeploymentID = types.StringValue(release.DeploymentId)
+
+	state.Labels, diags = types.MapValueFrom(ctx, types.StringType, release.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultWorkspace is the workspace Waypoint uses when none is given.
+const defaultWorkspace = "default"
+
+// operationSummaryModel maps the summary of a build, deployment or release.
+type operationSummaryModel struct {
+	ID       types.String `tfsdk:"id"`
+	Sequence types.Int64  `tfsdk:"sequence"`
+	Status   types.String `tfsdk:"status"`
+	URL      types.String `tfsdk:"url"`
+}
+
+// operationSummaryAttribute returns the computed schema of an
+// operationSummaryModel.
+func operationSummaryAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the operation",
+			},
+			"sequence": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Sequence number of the operation within its application",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the operation, one of `UNKNOWN`, `RUNNING`, `SUCCESS` or `ERROR`",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the operation, if the platform provides one",
+			},
+		},
+	}
+}
+
+// workspaceOrDefault returns the configured workspace, or the default
+// workspace when it is not set.
+func workspaceOrDefault(workspace types.String) string {
+	if workspace.IsNull() || workspace.ValueString() == "" {
+		return defaultWorkspace
+	}
+
+	return workspace.ValueString()
+}
+
+// latestDeployment returns the most recent successful deployment of an
+// application that has not been destroyed, or nil when there is none. The
+// Waypoint API has no GetLatestDeployment, so this lists deployments the
+// same way the Waypoint CLI looks up the latest one.
+func latestDeployment(ctx context.Context, client gen.WaypointClient, project, app, workspace string) (*gen.Deployment, error) {
+	resp, err := client.ListDeployments(ctx, &gen.ListDeploymentsRequest{
+		Application: &gen.Ref_Application{Project: project, Application: app},
+		Workspace:   &gen.Ref_Workspace{Workspace: workspace},
+		Status: []*gen.StatusFilter{{
+			Filters: []*gen.StatusFilter_Filter{{
+				Filter: &gen.StatusFilter_Filter_State{State: gen.Status_SUCCESS},
+			}},
+		}},
+		PhysicalState: gen.Operation_CREATED,
+		Order: &gen.OperationOrder{
+			Order: gen.OperationOrder_COMPLETE_TIME,
+			Desc:  true,
+			Limit: 1,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Deployments) == 0 {
+		return nil, nil
+	}
+
+	return resp.Deployments[0], nil
+}
+
+// latestRelease returns the most recent successful release of an
+// application, or nil when there is none.
+func latestRelease(ctx context.Context, client gen.WaypointClient, project, app, workspace string) (*gen.Release, error) {
+	release, err := client.GetLatestRelease(ctx, &gen.GetLatestReleaseRequest{
+		Application: &gen.Ref_Application{Project: project, Application: app},
+		Workspace:   &gen.Ref_Workspace{Workspace: workspace},
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+
+	return release, err
+}
+
+// flattenDeploymentSummary converts a deployment to its summary, or nil when
+// there is no deployment.
+func flattenDeploymentSummary(deployment *gen.Deployment) *operationSummaryModel {
+	if deployment == nil {
+		return nil
+	}
+
+	return &operationSummaryModel{
+		ID:       types.StringValue(deployment.Id),
+		Sequence: types.Int64Value(int64(deployment.Sequence)),
+		Status:   types.StringValue(deployment.GetStatus().GetState().String()),
+		URL:      types.StringValue(deployment.Url),
+	}
+}
+
+// flattenReleaseSummary converts a release to its summary, or nil when there
+// is no release.
+func flattenReleaseSummary(release *gen.Release) *operationSummaryModel {
+	if release == nil {
+		return nil
+	}
+
+	return &operationSummaryModel{
+		ID:       types.StringValue(release.Id),
+		Sequence: types.Int64Value(int64(release.Sequence)),
+		Status:   types.StringValue(release.GetStatus().GetState().String()),
+		URL:      types.StringValue(release.Url),
+	}
+}
+
+// latestOperationAttributes returns the schema attributes shared by the
+// data sources that look up the latest build, deployment or release of an
+// application. kind names the operation in descriptions.
+func latestOperationAttributes(kind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"project_name": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the Waypoint project",
+		},
+		"app_name": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the Waypoint application",
+		},
+		"workspace": schema.StringAttribute{
+			Optional:    true,
+			Description: "The workspace to look up the latest " + kind + " in. Defaults to `default`",
+		},
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the " + kind,
+		},
+		"sequence": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Sequence number of the " + kind + " within the application",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "Status of the " + kind + ", one of `UNKNOWN`, `RUNNING`, `SUCCESS` or `ERROR`",
+		},
+		"component": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the plugin that performed the " + kind + ", such as `docker` or `kubernetes`",
+		},
+		"labels": schema.MapAttribute{
+			Computed:    true,
+			Description: "Labels of the " + kind,
+			ElementType: types.StringType,
+		},
+		"job_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the job that performed the " + kind,
+		},
+	}
+}
+
+// latestBuild returns the most recent successful build of an application,
+// or nil when there is none.
+func latestBuild(ctx context.Context, client gen.WaypointClient, project, app, workspace string) (*gen.Build, error) {
+	build, err := client.GetLatestBuild(ctx, &gen.GetLatestBuildRequest{
+		Application: &gen.Ref_Application{Project: project, Application: app},
+		Workspace:   &gen.Ref_Workspace{Workspace: workspace},
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+
+	return build, err
+}
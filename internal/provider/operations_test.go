@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testOperationsClient returns a fixed list of deployments and no releases or
+// builds, recording the deployment list request.
+type testOperationsClient struct {
+	gen.WaypointClient
+	deployments []*gen.Deployment
+	listReq     *gen.ListDeploymentsRequest
+}
+
+func (c *testOperationsClient) ListDeployments(_ context.Context, req *gen.ListDeploymentsRequest, _ ...grpc.CallOption) (*gen.ListDeploymentsResponse, error) {
+	c.listReq = req
+	return &gen.ListDeploymentsResponse{Deployments: c.deployments}, nil
+}
+
+func (c *testOperationsClient) GetLatestRelease(context.Context, *gen.GetLatestReleaseRequest, ...grpc.CallOption) (*gen.Release, error) {
+	return nil, status.Error(codes.NotFound, "no releases")
+}
+
+func (c *testOperationsClient) GetLatestBuild(context.Context, *gen.GetLatestBuildRequest, ...grpc.CallOption) (*gen.Build, error) {
+	return nil, status.Error(codes.NotFound, "no builds")
+}
+
+func TestLatestDeployment(t *testing.T) {
+	client := &testOperationsClient{}
+
+	deployment, err := latestDeployment(context.Background(), client, "example", "web", defaultWorkspace)
+	if err != nil || deployment != nil {
+		t.Fatalf("expected no deployment, got %v (%v)", deployment, err)
+	}
+
+	req := client.listReq
+	if req.Application.Project != "example" || req.Application.Application != "web" || req.Workspace.Workspace != defaultWorkspace {
+		t.Errorf("unexpected application or workspace in request: %v", req)
+	}
+	if req.PhysicalState != gen.Operation_CREATED || req.Order.Limit != 1 || !req.Order.Desc {
+		t.Errorf("expected only the newest created deployment to be requested: %v", req)
+	}
+
+	client.deployments = []*gen.Deployment{{Id: "01GX", Sequence: 4, Url: "https://web.example.com"}}
+	deployment, err = latestDeployment(context.Background(), client, "example", "web", defaultWorkspace)
+	if err != nil || deployment.Id != "01GX" {
+		t.Fatalf("expected deployment 01GX, got %v (%v)", deployment, err)
+	}
+}
+
+func TestLatestRelease_notFound(t *testing.T) {
+	release, err := latestRelease(context.Background(), &testOperationsClient{}, "example", "web", defaultWorkspace)
+	if err != nil || release != nil {
+		t.Fatalf("expected no release and no error, got %v (%v)", release, err)
+	}
+}
+
+func TestLatestBuild_notFound(t *testing.T) {
+	build, err := latestBuild(context.Background(), &testOperationsClient{}, "example", "web", defaultWorkspace)
+	if err != nil || build != nil {
+		t.Fatalf("expected no build and no error, got %v (%v)", build, err)
+	}
+}
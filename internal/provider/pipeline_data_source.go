@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &pipelineDataSource{}
+	_ datasource.DataSourceWithConfigure = &pipelineDataSource{}
+)
+
+// NewPipelineDataSource is a helper function to simplify the provider implementation.
+func NewPipelineDataSource() datasource.DataSource {
+	return &pipelineDataSource{}
+}
+
+// pipelineDataSource is the data source implementation.
+type pipelineDataSource struct {
+	client waypointClient.Waypoint
+}
+
+// pipelineDataSourceModel maps the schema data.
+type pipelineDataSourceModel struct {
+	Project  types.String        `tfsdk:"project"`
+	Name     types.String        `tfsdk:"name"`
+	ID       types.String        `tfsdk:"id"`
+	RootStep types.String        `tfsdk:"root_step"`
+	Steps    []pipelineStepModel `tfsdk:"steps"`
+	LastRun  *pipelineRunModel   `tfsdk:"last_run"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *pipelineDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(waypointClient.Waypoint)
+}
+
+// Metadata returns the data source type name.
+func (d *pipelineDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline"
+}
+
+// Schema defines the schema for the data source
+func (d *pipelineDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := pipelineAttributes()
+	attributes["project"] = schema.StringAttribute{
+		Required:    true,
+		Description: "Name of the project the pipeline belongs to",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Required:    true,
+		Description: "Name of the pipeline",
+	}
+	attributes["root_step"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Name of the step the pipeline starts with",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up a pipeline of a Waypoint project. Pipelines are defined in the project's waypoint.hcl",
+		Attributes:  attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *pipelineDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state pipelineDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := state.Project.ValueString()
+	pipelineName := state.Name.ValueString()
+	ctx = tflog.SetField(ctx, "waypoint_project", projectName)
+	ctx = tflog.SetField(ctx, "waypoint_pipeline", pipelineName)
+
+	pipeline, err := d.client.GRPCClient().GetPipeline(ctx, &gen.GetPipelineRequest{
+		Pipeline: &gen.Ref_Pipeline{
+			Ref: &gen.Ref_Pipeline_Owner{Owner: &gen.Ref_PipelineOwner{
+				Project:      &gen.Ref_Project{Project: projectName},
+				PipelineName: pipelineName,
+			}},
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pipeline",
+			"Could not read Pipeline "+projectName+"/"+pipelineName+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(pipeline.GetPipeline().GetId())
+	state.RootStep = types.StringValue(pipeline.GetRootStep())
+	state.Steps = flattenPipelineSteps(pipeline.GetPipeline())
+
+	state.LastRun, err = latestPipelineRun(ctx, d.client.GRPCClient(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pipeline Runs",
+			"Could not read the latest run of Pipeline "+projectName+"/"+pipelineName+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sort"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Pipeline step kinds
+const (
+	pipelineStepKindExec     = "exec"
+	pipelineStepKindBuild    = "build"
+	pipelineStepKindDeploy   = "deploy"
+	pipelineStepKindRelease  = "release"
+	pipelineStepKindUp       = "up"
+	pipelineStepKindPipeline = "pipeline"
+)
+
+// pipelineStepModel maps a step of a pipeline.
+type pipelineStepModel struct {
+	Name      types.String   `tfsdk:"name"`
+	Kind      types.String   `tfsdk:"kind"`
+	DependsOn []types.String `tfsdk:"depends_on"`
+	Image     types.String   `tfsdk:"image"`
+	Workspace types.String   `tfsdk:"workspace"`
+}
+
+// pipelineRunModel maps the summary of a pipeline run.
+type pipelineRunModel struct {
+	ID       types.String `tfsdk:"id"`
+	Sequence types.Int64  `tfsdk:"sequence"`
+	State    types.String `tfsdk:"state"`
+}
+
+// pipelineAttributes returns the computed schema of the steps and last run
+// of a pipeline.
+func pipelineAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the pipeline",
+		},
+		"steps": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The steps of the pipeline, ordered by name",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "Name of the step",
+					},
+					"kind": schema.StringAttribute{
+						Computed:    true,
+						Description: "What the step does, one of `exec`, `build`, `deploy`, `release`, `up` or `pipeline`",
+					},
+					"depends_on": schema.ListAttribute{
+						Computed:    true,
+						Description: "Names of the steps that run before this step",
+						ElementType: types.StringType,
+					},
+					"image": schema.StringAttribute{
+						Computed:    true,
+						Description: "Image the step runs in, if set",
+					},
+					"workspace": schema.StringAttribute{
+						Computed:    true,
+						Description: "Workspace the step runs in, if it overrides the workspace of the run",
+					},
+				},
+			},
+		},
+		"last_run": schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "The most recent run of the pipeline. Null when the pipeline has never run",
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:    true,
+					Description: "ID of the run",
+				},
+				"sequence": schema.Int64Attribute{
+					Computed:    true,
+					Description: "Sequence number of the run within its pipeline",
+				},
+				"state": schema.StringAttribute{
+					Computed:    true,
+					Description: "State of the run, one of `PENDING`, `STARTING`, `RUNNING`, `ERROR`, `CANCELLED` or `SUCCESS`",
+				},
+			},
+		},
+	}
+}
+
+// flattenPipelineSteps converts the steps of a pipeline to their data
+// source model, ordered by name.
+func flattenPipelineSteps(pipeline *gen.Pipeline) []pipelineStepModel {
+	steps := []pipelineStepModel{}
+	for name, step := range pipeline.GetSteps() {
+		dependsOn := []types.String{}
+		for _, dep := range step.GetDependsOn() {
+			dependsOn = append(dependsOn, types.StringValue(dep))
+		}
+
+		model := pipelineStepModel{
+			Name:      types.StringValue(name),
+			Kind:      types.StringValue(pipelineStepKind(step)),
+			DependsOn: dependsOn,
+			Image:     types.StringNull(),
+			Workspace: types.StringNull(),
+		}
+		if step.GetImage() != "" {
+			model.Image = types.StringValue(step.GetImage())
+		}
+		if step.GetWorkspace().GetWorkspace() != "" {
+			model.Workspace = types.StringValue(step.GetWorkspace().GetWorkspace())
+		}
+		steps = append(steps, model)
+	}
+	sort.Slice(steps, func(i, j int) bool {
+		return steps[i].Name.ValueString() < steps[j].Name.ValueString()
+	})
+
+	return steps
+}
+
+// pipelineStepKind returns the kind of a pipeline step.
+func pipelineStepKind(step *gen.Pipeline_Step) string {
+	switch step.GetKind().(type) {
+	case *gen.Pipeline_Step_Exec_:
+		return pipelineStepKindExec
+	case *gen.Pipeline_Step_Build_:
+		return pipelineStepKindBuild
+	case *gen.Pipeline_Step_Deploy_:
+		return pipelineStepKindDeploy
+	case *gen.Pipeline_Step_Release_:
+		return pipelineStepKindRelease
+	case *gen.Pipeline_Step_Up_:
+		return pipelineStepKindUp
+	case *gen.Pipeline_Step_Pipeline_:
+		return pipelineStepKindPipeline
+	}
+
+	return ""
+}
+
+// latestPipelineRun returns the summary of the most recent run of the
+// pipeline with the given ID, or nil when it has never run.
+func latestPipelineRun(ctx context.Context, client gen.WaypointClient, id string) (*pipelineRunModel, error) {
+	resp, err := client.GetLatestPipelineRun(ctx, &gen.GetPipelineRequest{
+		Pipeline: &gen.Ref_Pipeline{Ref: &gen.Ref_Pipeline_Id{Id: id}},
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	run := resp.GetPipelineRun()
+	if run == nil {
+		return nil, nil
+	}
+
+	return &pipelineRunModel{
+		ID:       types.StringValue(run.Id),
+		Sequence: types.Int64Value(int64(run.Sequence)),
+		State:    types.StringValue(run.State.String()),
+	}, nil
+}
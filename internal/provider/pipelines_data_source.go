@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sort"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &pipelinesDataSource{}
+	_ datasource.DataSourceWithConfigure = &pipelinesDataSource{}
+)
+
+// NewPipelinesDataSource is a helper function to simplify the provider implementation.
+func NewPipelinesDataSource() datasource.DataSource {
+	return &pipelinesDataSource{}
+}
+
+// pipelinesDataSource is the data source implementation.
+type pipelinesDataSource struct {
+	client waypointClient.Waypoint
+}
+
+// pipelinesDataSourceModel maps the schema data.
+type pipelinesDataSourceModel struct {
+	Project   types.String    `tfsdk:"project"`
+	Pipelines []pipelineModel `tfsdk:"pipelines"`
+}
+
+// pipelineModel maps a pipeline in the list.
+type pipelineModel struct {
+	ID      types.String        `tfsdk:"id"`
+	Name    types.String        `tfsdk:"name"`
+	Steps   []pipelineStepModel `tfsdk:"steps"`
+	LastRun *pipelineRunModel   `tfsdk:"last_run"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *pipelinesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(waypointClient.Waypoint)
+}
+
+// Metadata returns the data source type name.
+func (d *pipelinesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipelines"
+}
+
+// Schema defines the schema for the data source
+func (d *pipelinesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := pipelineAttributes()
+	attributes["name"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Name of the pipeline",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the pipelines of a Waypoint project. Pipelines are defined in the project's waypoint.hcl",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the project to list the pipelines of",
+			},
+			"pipelines": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The pipelines of the project, ordered by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: attributes,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *pipelinesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state pipelinesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := state.Project.ValueString()
+	ctx = tflog.SetField(ctx, "waypoint_project", projectName)
+
+	pipelines, err := d.client.GRPCClient().ListPipelines(ctx, &gen.ListPipelinesRequest{
+		Project: &gen.Ref_Project{Project: projectName},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Pipelines",
+			"Could not list pipelines of Project "+projectName+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Pipelines = []pipelineModel{}
+	for _, pipeline := range pipelines.Pipelines {
+		lastRun, err := latestPipelineRun(ctx, d.client.GRPCClient(), pipeline.Id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Pipeline Runs",
+				"Could not read the latest run of Pipeline "+projectName+"/"+pipeline.Name+": "+err.Error(),
+			)
+			return
+		}
+
+		state.Pipelines = append(state.Pipelines, pipelineModel{
+			ID:      types.StringValue(pipeline.Id),
+			Name:    types.StringValue(pipeline.Name),
+			Steps:   flattenPipelineSteps(pipeline),
+			LastRun: lastRun,
+		})
+	}
+	sort.Slice(state.Pipelines, func(i, j int) bool {
+		return state.Pipelines[i].Name.ValueString() < state.Pipelines[j].Name.ValueString()
+	})
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testPipelinesClient returns a fixed latest run, or NotFound when there is
+// none.
+type testPipelinesClient struct {
+	gen.WaypointClient
+	run *gen.PipelineRun
+}
+
+func (c *testPipelinesClient) GetLatestPipelineRun(context.Context, *gen.GetPipelineRequest, ...grpc.CallOption) (*gen.GetPipelineRunResponse, error) {
+	if c.run == nil {
+		return nil, status.Error(codes.NotFound, "pipeline run not found")
+	}
+	return &gen.GetPipelineRunResponse{PipelineRun: c.run}, nil
+}
+
+func TestFlattenPipelineSteps(t *testing.T) {
+	steps := flattenPipelineSteps(&gen.Pipeline{
+		Steps: map[string]*gen.Pipeline_Step{
+			"test": {
+				Name:  "test",
+				Image: "golang:1.19",
+				Kind:  &gen.Pipeline_Step_Exec_{Exec: &gen.Pipeline_Step_Exec{Command: "go"}},
+			},
+			"build": {
+				Name:      "build",
+				DependsOn: []string{"test"},
+				Kind:      &gen.Pipeline_Step_Build_{Build: &gen.Pipeline_Step_Build{}},
+				Workspace: &gen.Ref_Workspace{Workspace: "staging"},
+			},
+		},
+	})
+
+	if len(steps) != 2 || steps[0].Name.ValueString() != "build" || steps[1].Name.ValueString() != "test" {
+		t.Fatalf("expected steps ordered by name, got %+v", steps)
+	}
+	if steps[0].Kind.ValueString() != pipelineStepKindBuild || len(steps[0].DependsOn) != 1 || !steps[0].Image.IsNull() {
+		t.Errorf("unexpected build step %+v", steps[0])
+	}
+	if steps[0].Workspace.ValueString() != "staging" || !steps[1].Workspace.IsNull() {
+		t.Errorf("expected only the build step to override the workspace, got %v and %v", steps[0].Workspace, steps[1].Workspace)
+	}
+	if steps[1].Kind.ValueString() != pipelineStepKindExec || steps[1].Image.ValueString() != "golang:1.19" {
+		t.Errorf("unexpected test step %+v", steps[1])
+	}
+}
+
+func TestLatestPipelineRun(t *testing.T) {
+	client := &testPipelinesClient{}
+	run, err := latestPipelineRun(context.Background(), client, "01GP")
+	if err != nil || run != nil {
+		t.Errorf("expected no run for a pipeline that never ran, got %+v (%v)", run, err)
+	}
+
+	client.run = &gen.PipelineRun{Id: "01GR", Sequence: 3, State: gen.PipelineRun_SUCCESS}
+	run, err = latestPipelineRun(context.Background(), client, "01GP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.ID.ValueString() != "01GR" || run.Sequence.ValueInt64() != 3 || run.State.ValueString() != "SUCCESS" {
+		t.Errorf("unexpected run %+v", run)
+	}
+}
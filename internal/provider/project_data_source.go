@@ -5,14 +5,15 @@ package provider
 
 import (
 	"context"
-	"time"
 
 	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
 	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-waypoint/internal/timetypes"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -36,11 +37,12 @@ type projectDataSource struct {
 // projectDataSourceModel maps the schema data. This embeds the
 // projectResourceModel struct, and adds Application information
 type projectDataSourceModel struct {
-	Applications         types.List        `tfsdk:"applications"`
-	Name                 types.String      `tfsdk:"project_name"`
-	Variables            []*variablesModel `tfsdk:"project_variables"`
-	RemoteRunnersEnabled types.Bool        `tfsdk:"remote_runners_enabled"`
-	AppStatusPollSeconds types.Int64       `tfsdk:"app_status_poll_seconds"`
+	Applications         types.List                 `tfsdk:"applications"`
+	Name                 types.String               `tfsdk:"project_name"`
+	Variables            map[string]*variablesModel `tfsdk:"variables"`
+	RemoteRunnersEnabled types.Bool                 `tfsdk:"remote_runners_enabled"`
+	AppStatusPoll        timetypes.GoDuration       `tfsdk:"app_status_poll_interval"`
+	AppStatusPollEnabled types.Bool                 `tfsdk:"app_status_polling_enabled"`
 
 	DataSourceGit *dataSourceGitModel `tfsdk:"data_source_git"`
 	GitAuthBasic  *gitAuthBasicModel  `tfsdk:"git_auth_basic"`
@@ -74,16 +76,18 @@ func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "List of applications for this project",
 				ElementType: types.StringType,
 			},
-			"project_variables": schema.ListNestedAttribute{
+			"variables": schema.MapNestedAttribute{
 				Computed:    true,
 				Sensitive:   true,
-				Description: "List of variables in Key/value pairs associated with the Waypoint Project",
+				Description: "Variables associated with the Waypoint Project, keyed by variable name",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Required: true},
 						"value": schema.StringAttribute{
-							Required: true},
+							Computed: true},
+						"sensitive_value": schema.StringAttribute{
+							Computed:  true,
+							Sensitive: true,
+						},
 						"sensitive": schema.BoolAttribute{
 							Computed: true,
 						},
@@ -93,32 +97,7 @@ func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 			"data_source_git": &schema.SingleNestedAttribute{
 				Computed:    true,
 				Description: "Configuration of Git repository where waypoint.hcl file is stored",
-				Attributes: map[string]schema.Attribute{
-					"git_url": &schema.StringAttribute{
-						Computed:    true,
-						Description: "Url of git repository storing the waypoint.hcl file",
-					},
-					"git_path": &schema.StringAttribute{
-						Computed:    true,
-						Description: "Path in git repository when waypoint.hcl file is stored in a sub-directory",
-					},
-					"git_ref": &schema.StringAttribute{
-						Computed:    true,
-						Description: "Git repository ref containing waypoint.hcl file",
-					},
-					"ignore_changes_outside_path": &schema.BoolAttribute{
-						Computed:    true,
-						Description: "Whether Waypoint ignores changes outside path storing waypoint.hcl file",
-					},
-					"git_poll_interval_seconds": &schema.Int64Attribute{
-						Computed:    true,
-						Description: "Interval at which Waypoint should poll git repository for changes",
-					},
-					"file_change_signal": &schema.StringAttribute{
-						Computed:    true,
-						Description: "Indicates signal to be sent to any applications when their config files change.",
-					},
-				},
+				Attributes:  dataSourceGitAttributes(),
 			},
 			"remote_runners_enabled": &schema.BoolAttribute{
 				Computed:    true,
@@ -161,9 +140,14 @@ func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 					},
 				},
 			},
-			"app_status_poll_seconds": &schema.Int64Attribute{
+			"app_status_poll_interval": &schema.StringAttribute{
+				CustomType:  timetypes.GoDurationType{},
+				Computed:    true,
+				Description: "Application status poll interval",
+			},
+			"app_status_polling_enabled": &schema.BoolAttribute{
 				Computed:    true,
-				Description: "Application status poll interval in seconds",
+				Description: "Whether Waypoint polls for application status reports",
 			},
 		},
 	}
@@ -198,81 +182,44 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	// build up list of applications
-	var appListStr []string
-	if apps := project.GetApplications(); apps != nil {
-		for _, app := range apps {
-			appListStr = append(appListStr, app.GetName())
-		}
-	}
-
-	apps, diags := types.ListValueFrom(ctx, types.StringType, appListStr)
+	state.Applications, diags = flattenApplications(ctx, project.GetApplications())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.Applications = apps
 
 	state.RemoteRunnersEnabled = types.BoolValue(project.RemoteEnabled)
 
-	var projectVariables []*variablesModel
-	for _, v := range project.Variables {
-		pvar := variablesModel{}
-		pvar.Name = types.StringValue(v.Name)
-		// we only support string values(?)
-		str := v.Value.(*gen.Variable_Str).Str
-		pvar.Value = types.StringValue(str)
-		pvar.Sensitive = types.BoolValue(v.Sensitive)
-		projectVariables = append(projectVariables, &pvar)
+	state.Variables = flattenProjectVariables(project.Variables, nil, nil)
+
+	state.DataSourceGit, diags = flattenDataSourceGit(ctx, project)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	state.Variables = projectVariables
 
-	// TODO: This should be a &gen.Job_DataSource_Git from the protos. Not yet
-	// sure what to do here if it's a _Local or _Remote version of that
-	// dataSource := project.DataSource.Source
-	var dsg *dataSourceGitModel
 	var gab *gitAuthBasicModel
 	var gas *gitAuthSSHModel
-	if project.DataSource != nil {
-		switch project.DataSource.Source.(type) {
-		case *gen.Job_DataSource_Local, *gen.Job_DataSource_Remote:
-		// not sure what to do here
-		default:
-			// assumes *gen.Job_DataSource_Git
-			src := project.DataSource.Source.(*gen.Job_DataSource_Git)
-			var poll int64
-			if project.DataSourcePoll != nil {
-				pollRaw, _ := time.ParseDuration(project.DataSourcePoll.Interval)
-				poll = int64(pollRaw / time.Second)
-			}
-			dsg = &dataSourceGitModel{
-				Url:                      types.StringValue(src.Git.Url),
-				Ref:                      types.StringValue(src.Git.Ref),
-				Path:                     types.StringValue(src.Git.Path),
-				IgnoreChangesOutsidePath: types.BoolValue(src.Git.IgnoreChangesOutsidePath),
-				PollInterval:             types.Int64Value(int64(poll)),
-				FileChangeSignal:         types.StringValue(project.FileChangeSignal),
-			}
-
-			authRaw := src.Git.Auth
-			switch gitAuth := authRaw.(type) {
-			case *gen.Job_Git_Basic_:
-				gab = &gitAuthBasicModel{}
-				gab.Username = types.StringValue(gitAuth.Basic.Username)
-			case *gen.Job_Git_Ssh:
-				gas = &gitAuthSSHModel{}
-				gas.User = types.StringValue(gitAuth.Ssh.User)
-			}
+	if src, ok := project.GetDataSource().GetSource().(*gen.Job_DataSource_Git); ok {
+		switch gitAuth := src.Git.Auth.(type) {
+		case *gen.Job_Git_Basic_:
+			gab = &gitAuthBasicModel{}
+			gab.Username = types.StringValue(gitAuth.Basic.Username)
+		case *gen.Job_Git_Ssh:
+			gas = &gitAuthSSHModel{}
+			gas.User = types.StringValue(gitAuth.Ssh.User)
 		}
 	}
-	state.DataSourceGit = dsg
 	state.GitAuthBasic = gab
 	state.GitAuthSSH = gas
 
 	if project.StatusReportPoll != nil {
-		pollRaw, _ := time.ParseDuration(project.StatusReportPoll.Interval)
-		poll := pollRaw / time.Second
-		state.AppStatusPollSeconds = types.Int64Value(int64(poll))
+		state.AppStatusPoll, diags = flattenPollInterval(ctx, timetypes.NewGoDurationNull(), project.StatusReportPoll.Interval)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.AppStatusPollEnabled = types.BoolValue(project.StatusReportPoll.Enabled)
 	}
 
 	// Set refreshed state
@@ -282,3 +229,69 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 }
+
+// flattenDataSourceGit converts the git data source of a project for the
+// data source schemas, which report the server's values as they are. It
+// returns nil for projects using a local or remote data source.
+func flattenDataSourceGit(ctx context.Context, project *gen.Project) (*dataSourceGitModel, diag.Diagnostics) {
+	src, ok := project.GetDataSource().GetSource().(*gen.Job_DataSource_Git)
+	if !ok {
+		return nil, nil
+	}
+
+	poll, diags := flattenPollInterval(ctx, timetypes.NewGoDurationNull(), project.DataSourcePoll.GetInterval())
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &dataSourceGitModel{
+		Url:                      types.StringValue(src.Git.Url),
+		Ref:                      types.StringValue(src.Git.Ref),
+		Path:                     types.StringValue(src.Git.Path),
+		IgnoreChangesOutsidePath: types.BoolValue(src.Git.IgnoreChangesOutsidePath),
+		PollInterval:             poll,
+		PollingEnabled:           types.BoolValue(project.DataSourcePoll.GetEnabled()),
+		RecurseSubmodules:        types.Int64Value(int64(src.Git.RecurseSubmodules)),
+		FileChangeSignal:         types.StringValue(project.FileChangeSignal),
+	}, diags
+}
+
+// dataSourceGitAttributes returns the computed attributes describing the git
+// data source of a project.
+func dataSourceGitAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"git_url": &schema.StringAttribute{
+			Computed:    true,
+			Description: "Url of git repository storing the waypoint.hcl file",
+		},
+		"git_path": &schema.StringAttribute{
+			Computed:    true,
+			Description: "Path in git repository when waypoint.hcl file is stored in a sub-directory",
+		},
+		"git_ref": &schema.StringAttribute{
+			Computed:    true,
+			Description: "Git repository ref containing waypoint.hcl file",
+		},
+		"ignore_changes_outside_path": &schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether Waypoint ignores changes outside path storing waypoint.hcl file",
+		},
+		"git_poll_interval": &schema.StringAttribute{
+			CustomType:  timetypes.GoDurationType{},
+			Computed:    true,
+			Description: "Interval at which Waypoint should poll git repository for changes",
+		},
+		"git_polling_enabled": &schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether Waypoint polls the git repository for changes",
+		},
+		"recurse_submodules": &schema.Int64Attribute{
+			Computed:    true,
+			Description: "Maximum depth of submodules cloned recursively. 0 means submodules are not cloned",
+		},
+		"file_change_signal": &schema.StringAttribute{
+			Computed:    true,
+			Description: "Indicates signal to be sent to any applications when their config files change.",
+		},
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"golang.org/x/crypto/ssh"
+)
+
+// gitURLKind is the transport a git URL is cloned over, which determines the
+// kind of authentication it can use.
+type gitURLKind int
+
+const (
+	gitURLHTTP gitURLKind = iota
+	gitURLSSH
+	gitURLOther
+)
+
+// scpLikeGitURL matches the scp-like syntax git accepts for SSH, such as
+// git@github.com:hashicorp/waypoint-examples.git or git@host:/srv/repo.git.
+// Like git, a single letter host is taken to be a Windows drive rather than a
+// host.
+var scpLikeGitURL = regexp.MustCompile(`^(?:[^@/:]+@)?[^@/:\\]{2,}:[^\\]`)
+
+// parseGitURL checks that rawURL is a URL git can clone from and reports the
+// transport it uses.
+func parseGitURL(rawURL string) (gitURLKind, error) {
+	if rawURL == "" {
+		return gitURLOther, errors.New("URL must not be empty")
+	}
+
+	if !strings.Contains(rawURL, "://") {
+		if scpLikeGitURL.MatchString(rawURL) {
+			return gitURLSSH, nil
+		}
+		return gitURLOther, errors.New("URL must have a scheme such as https:// or ssh://, or use the scp-like user@host:path form")
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return gitURLOther, err
+	}
+
+	// The server clones the repository on a runner, which cannot read a path
+	// that is local to where Terraform runs.
+	if u.Scheme == "file" {
+		return gitURLOther, errors.New("file:// URLs are not supported, as the Waypoint server clones the repository on a runner and cannot read local paths. Use a URL the runner can reach, such as https:// or ssh://")
+	}
+
+	if u.Host == "" {
+		return gitURLOther, errors.New("URL must include a host")
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		return gitURLHTTP, nil
+	case "ssh", "git+ssh":
+		return gitURLSSH, nil
+	case "git":
+		return gitURLOther, nil
+	default:
+		return gitURLOther, fmt.Errorf("unsupported URL scheme %q", u.Scheme)
+	}
+}
+
+// validateGitRef checks ref against the rules of git check-ref-format, with
+// the addition that a single level name such as "main" or "HEAD" is allowed.
+func validateGitRef(ref string) error {
+	switch {
+	case ref == "":
+		return errors.New("ref must not be empty")
+	case ref == "@":
+		return errors.New("ref must not be @")
+	case strings.HasPrefix(ref, "-"):
+		return errors.New("ref must not begin with -")
+	case strings.HasPrefix(ref, "/"), strings.HasSuffix(ref, "/"):
+		return errors.New("ref must not begin or end with /")
+	case strings.HasSuffix(ref, "."):
+		return errors.New("ref must not end with .")
+	case strings.Contains(ref, ".."):
+		return errors.New("ref must not contain ..")
+	case strings.Contains(ref, "//"):
+		return errors.New("ref must not contain consecutive slashes")
+	case strings.Contains(ref, "@{"):
+		return errors.New("ref must not contain @{")
+	}
+
+	for _, r := range ref {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(" ~^:?*[\\", r) {
+			return fmt.Errorf("ref must not contain %q", r)
+		}
+	}
+
+	for _, component := range strings.Split(ref, "/") {
+		if strings.HasPrefix(component, ".") {
+			return errors.New("ref components must not begin with .")
+		}
+		if strings.HasSuffix(component, ".lock") {
+			return errors.New("ref components must not end with .lock")
+		}
+	}
+
+	return nil
+}
+
+// validateSSHPrivateKey checks that key is a PEM encoded private key that
+// can be used with passphrase, which may be empty for unencrypted keys.
+func validateSSHPrivateKey(key, passphrase string) error {
+	_, err := ssh.ParseRawPrivateKey([]byte(key))
+
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) {
+		if passphrase == "" {
+			return errors.New("private key is encrypted, but no passphrase is set")
+		}
+		_, err = ssh.ParseRawPrivateKeyWithPassphrase([]byte(key), []byte(passphrase))
+	}
+
+	return err
+}
+
+// validateProjectGit checks the git data source and authentication of a
+// project configuration without contacting the server or the repository.
+// Unknown values are skipped, as they are only validated once known.
+func validateProjectGit(data projectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.DataSourceGit == nil {
+		return diags
+	}
+	gitPath := path.Root("data_source_git")
+
+	if u := data.DataSourceGit.Url; !u.IsNull() && !u.IsUnknown() {
+		kind, err := parseGitURL(u.ValueString())
+		switch {
+		case err != nil:
+			diags.AddAttributeError(
+				gitPath.AtName("git_url"),
+				"Invalid Git URL",
+				"Could not parse git_url "+u.ValueString()+": "+err.Error(),
+			)
+		case kind == gitURLHTTP && data.GitAuthSSH != nil:
+			diags.AddAttributeError(
+				path.Root("git_auth_ssh"),
+				"Git Authentication Does Not Match URL",
+				"git_auth_ssh can only be used with an SSH git_url, but "+u.ValueString()+" is fetched over HTTP. "+
+					"Use git_auth_basic or an SSH URL such as git@host:org/repo.git.",
+			)
+		case kind == gitURLSSH && data.GitAuthBasic != nil:
+			diags.AddAttributeError(
+				path.Root("git_auth_basic"),
+				"Git Authentication Does Not Match URL",
+				"git_auth_basic can only be used with an HTTP git_url, but "+u.ValueString()+" is fetched over SSH. "+
+					"Use git_auth_ssh or an https:// URL.",
+			)
+		}
+	}
+
+	if ref := data.DataSourceGit.Ref; !ref.IsNull() && !ref.IsUnknown() {
+		if err := validateGitRef(ref.ValueString()); err != nil {
+			diags.AddAttributeError(
+				gitPath.AtName("git_ref"),
+				"Invalid Git Ref",
+				"git_ref "+ref.ValueString()+" is not a valid git ref: "+err.Error(),
+			)
+		}
+	}
+
+	if data.GitAuthSSH != nil {
+		key := data.GitAuthSSH.PrivateKey
+		passphrase := data.GitAuthSSH.Passphrase
+		if !key.IsNull() && !key.IsUnknown() && !passphrase.IsUnknown() {
+			if err := validateSSHPrivateKey(key.ValueString(), passphrase.ValueString()); err != nil {
+				diags.AddAttributeError(
+					path.Root("git_auth_ssh").AtName("ssh_private_key"),
+					"Invalid SSH Private Key",
+					"Could not parse ssh_private_key: "+err.Error(),
+				)
+			}
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,520 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// gitAccessTimeout bounds how long verify_git_access waits on the remote.
+const gitAccessTimeout = 30 * time.Second
+
+// gitHostKeyCheck configures how the host key of an SSH git remote is
+// verified. Without a fingerprint, the host key must be in the known_hosts
+// file.
+type gitHostKeyCheck struct {
+	Fingerprint    string
+	KnownHostsPath string
+	Insecure       bool
+}
+
+// gitCredentials holds the authentication used to reach a git remote. At
+// most one of the basic or SSH fields is set.
+type gitCredentials struct {
+	Username string
+	Password string
+
+	SSHUser       string
+	SSHPrivateKey string
+	SSHPassphrase string
+}
+
+// verifyGitAccess lists the refs of the project's git repository with the
+// configured credentials, like git ls-remote, and checks git_ref exists. It
+// runs from the provider rather than the Waypoint server, so it only proves
+// the repository is reachable from where Terraform runs.
+func verifyGitAccess(ctx context.Context, plan projectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	gitURL := plan.DataSourceGit.Url.ValueString()
+	ctx = tflog.SetField(ctx, "git_url", gitURL)
+
+	var creds gitCredentials
+	if plan.GitAuthBasic != nil {
+		creds.Username = plan.GitAuthBasic.Username.ValueString()
+		creds.Password = plan.GitAuthBasic.Password.ValueString()
+	} else if plan.GitAuthSSH != nil {
+		creds.SSHUser = plan.GitAuthSSH.User.ValueString()
+		creds.SSHPrivateKey = plan.GitAuthSSH.PrivateKey.ValueString()
+		creds.SSHPassphrase = plan.GitAuthSSH.Passphrase.ValueString()
+	}
+
+	hostKeys := gitHostKeyCheck{
+		Fingerprint: plan.VerifyGitHostKeyFingerprint.ValueString(),
+		Insecure:    plan.VerifyGitInsecureIgnoreHostKey.ValueBool(),
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		hostKeys.KnownHostsPath = filepath.Join(home, ".ssh", "known_hosts")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gitAccessTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, "Verifying git repository access")
+	refs, err := listGitRefs(ctx, gitURL, creds, hostKeys)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("data_source_git").AtName("git_url"),
+			"Git Repository Not Accessible",
+			"Could not list refs of "+gitURL+" with the configured credentials: "+err.Error(),
+		)
+		return diags
+	}
+
+	ref := plan.DataSourceGit.Ref.ValueString()
+	if !gitRefExists(refs, ref) {
+		diags.AddAttributeError(
+			path.Root("data_source_git").AtName("git_ref"),
+			"Git Ref Not Found",
+			"Repository "+gitURL+" is accessible but has no branch, tag or ref named "+ref+".",
+		)
+	}
+
+	return diags
+}
+
+// gitRefExists reports whether ref names one of the advertised refs. Like
+// git, a short name is tried as a branch and a tag, and since commits are
+// not advertised, anything that looks like a commit hash is assumed to
+// exist. An empty ref means the default branch.
+func gitRefExists(refs map[string]string, ref string) bool {
+	if ref == "" {
+		return true
+	}
+
+	for _, candidate := range []string{ref, "refs/" + ref, "refs/heads/" + ref, "refs/tags/" + ref} {
+		if _, ok := refs[candidate]; ok {
+			return true
+		}
+	}
+
+	if len(ref) >= 7 && len(ref) <= 40 && strings.Trim(strings.ToLower(ref), "0123456789abcdef") == "" {
+		return true
+	}
+
+	return false
+}
+
+// listGitRefs returns the refs advertised by the git remote at rawURL, keyed
+// by ref name.
+//
+// Only the ref advertisement that opens both the smart HTTP and the SSH
+// protocol is read, so this speaks just enough of the git wire protocol to
+// get it rather than shelling out to git ls-remote, which would need a git
+// binary and SSH configuration wherever Terraform runs, or depending on a
+// full git implementation for a preflight check. The SSH transport also has
+// to verify host keys itself, which accounts for most of the code below.
+func listGitRefs(ctx context.Context, rawURL string, creds gitCredentials, hostKeys gitHostKeyCheck) (map[string]string, error) {
+	kind, err := parseGitURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case gitURLHTTP:
+		return listGitRefsHTTP(ctx, rawURL, creds)
+	case gitURLSSH:
+		return listGitRefsSSH(ctx, rawURL, creds, hostKeys)
+	default:
+		return nil, errors.New("access can only be verified for http(s) and SSH URLs")
+	}
+}
+
+// listGitRefsHTTP performs the ref discovery of the git smart HTTP protocol.
+func listGitRefsHTTP(ctx context.Context, rawURL string, creds gitCredentials) (map[string]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(rawURL, "/")+"/info/refs?service=git-upload-pack", nil)
+	if err != nil {
+		return nil, err
+	}
+	if creds.Username != "" || creds.Password != "" {
+		req.SetBasicAuth(creds.Username, creds.Password)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, fmt.Errorf("authentication failed (%s)", resp.Status)
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("repository not found (%s)", resp.Status)
+	default:
+		return nil, fmt.Errorf("unexpected response %s", resp.Status)
+	}
+
+	if ct := resp.Header.Get("Content-Type"); ct != "application/x-git-upload-pack-advertisement" {
+		return nil, fmt.Errorf("server does not support the git smart HTTP protocol (content type %q)", ct)
+	}
+
+	r := bufio.NewReader(resp.Body)
+
+	// Smart HTTP prefixes the advertisement with a service announcement
+	// packet and a flush packet
+	announcement, err := readPktLine(r)
+	if err != nil {
+		return nil, err
+	}
+	flush, err := readPktLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(announcement, []byte("# service=")) || flush != nil {
+		return nil, errors.New("invalid smart HTTP service announcement")
+	}
+
+	return readRefAdvertisement(r)
+}
+
+// listGitRefsSSH runs git-upload-pack on the remote and reads its ref
+// advertisement, hanging up before any objects are negotiated.
+func listGitRefsSSH(ctx context.Context, rawURL string, creds gitCredentials, hostKeys gitHostKeyCheck) (map[string]string, error) {
+	user, host, repoPath, err := splitSSHGitURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if creds.SSHUser != "" {
+		user = creds.SSHUser
+	}
+
+	var auth []ssh.AuthMethod
+	if creds.SSHPrivateKey != "" {
+		// Like validateSSHPrivateKey, the passphrase is only used when the
+		// key turns out to be encrypted.
+		signer, err := ssh.ParsePrivateKey([]byte(creds.SSHPrivateKey))
+		var missing *ssh.PassphraseMissingError
+		if errors.As(err, &missing) && creds.SSHPassphrase != "" {
+			signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(creds.SSHPrivateKey), []byte(creds.SSHPassphrase))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not parse private key: %w", err)
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+
+	client, err := dialGitSSH(ctx, host, &ssh.ClientConfig{User: user, Auth: auth}, hostKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	var stderr bytes.Buffer
+	session.Stderr = &stderr
+
+	if err := session.Start("git-upload-pack '" + strings.ReplaceAll(repoPath, "'", `'\''`) + "'"); err != nil {
+		return nil, err
+	}
+
+	refs, err := readRefAdvertisement(bufio.NewReader(stdout))
+	if err != nil {
+		// stderr is only fully copied once the command has exited
+		_ = session.Wait()
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, errors.New(msg)
+		}
+		return nil, err
+	}
+
+	// A flush packet tells upload-pack we want nothing and ends the session
+	_, _ = stdin.Write([]byte("0000"))
+	_ = stdin.Close()
+
+	return refs, nil
+}
+
+// splitSSHGitURL returns the user, host:port and repository path of an SSH
+// git URL in either the ssh:// or the scp-like form.
+func splitSSHGitURL(rawURL string) (user, host, repoPath string, err error) {
+	user = "git"
+
+	if !strings.Contains(rawURL, "://") {
+		userHost, p, _ := strings.Cut(rawURL, ":")
+		if u, h, ok := strings.Cut(userHost, "@"); ok {
+			user, userHost = u, h
+		}
+		return user, net.JoinHostPort(userHost, "22"), p, nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", "", err
+	}
+	if u.User != nil && u.User.Username() != "" {
+		user = u.User.Username()
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "22"
+	}
+
+	// ssh://host/~user/repo is relative to a home directory
+	repoPath = u.Path
+	if strings.HasPrefix(repoPath, "/~") {
+		repoPath = repoPath[1:]
+	}
+
+	return user, net.JoinHostPort(u.Hostname(), port), repoPath, nil
+}
+
+// readRefAdvertisement reads the ref advertisement of git protocol v0, up
+// to and including its terminating flush packet.
+func readRefAdvertisement(r *bufio.Reader) (map[string]string, error) {
+	refs := make(map[string]string)
+
+	for first := true; ; first = false {
+		line, err := readPktLine(r)
+		if err != nil {
+			return nil, err
+		}
+		if line == nil {
+			return refs, nil
+		}
+
+		line = bytes.TrimSuffix(line, []byte("\n"))
+		if bytes.HasPrefix(line, []byte("ERR ")) {
+			return nil, errors.New(string(line[4:]))
+		}
+
+		// Capabilities follow the first ref after a NUL byte
+		if first {
+			line, _, _ = bytes.Cut(line, []byte{0})
+		}
+
+		sha, name, ok := bytes.Cut(line, []byte(" "))
+		if !ok {
+			return nil, fmt.Errorf("invalid ref advertisement line %q", line)
+		}
+
+		// An empty repository advertises only its capabilities
+		if string(name) == "capabilities^{}" {
+			continue
+		}
+		refs[string(name)] = string(sha)
+	}
+}
+
+// readPktLine reads one git pkt-line, returning nil for a flush packet.
+func readPktLine(r *bufio.Reader) ([]byte, error) {
+	var size [4]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		return nil, fmt.Errorf("could not read git protocol response: %w", err)
+	}
+
+	// The length includes its own four bytes, and 0000 is a flush packet
+	n, err := strconv.ParseUint(string(size[:]), 16, 16)
+	if err != nil || (n > 0 && n < 4) {
+		return nil, fmt.Errorf("invalid git protocol packet length %q", size)
+	}
+	if n == 0 {
+		return nil, nil
+	}
+
+	line := make([]byte, n-4)
+	if _, err := io.ReadFull(r, line); err != nil {
+		return nil, fmt.Errorf("could not read git protocol response: %w", err)
+	}
+
+	return line, nil
+}
+
+// dialGitSSH connects to the SSH git remote at host, verifying its host key
+// as configured by hostKeys.
+func dialGitSSH(ctx context.Context, host string, config *ssh.ClientConfig, hostKeys gitHostKeyCheck) (*ssh.Client, error) {
+	switch {
+	case hostKeys.Insecure:
+		config.HostKeyCallback = ssh.InsecureIgnoreHostKey()
+		return dialSSH(ctx, host, config, nil)
+	case hostKeys.Fingerprint != "":
+		return dialGitSSHFingerprint(ctx, host, config, hostKeys.Fingerprint)
+	}
+
+	if hostKeys.KnownHostsPath == "" {
+		return nil, errors.New("could not find the known_hosts file to verify the host key, set verify_git_host_key_fingerprint instead")
+	}
+	callback, err := knownhosts.New(hostKeys.KnownHostsPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read known hosts to verify the host key: %w", err)
+	}
+	config.HostKeyCallback = callback
+
+	return dialSSH(ctx, host, config, func(remote net.Addr) error {
+		// Checking a throwaway key reports the keys known for the host, so
+		// only their algorithms are negotiated. The server could otherwise
+		// present a key of another type, which would fail the check.
+		probe, _, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return err
+		}
+		probeKey, err := ssh.NewPublicKey(probe)
+		if err != nil {
+			return err
+		}
+
+		var keyErr *knownhosts.KeyError
+		if err := callback(host, remote, probeKey); !errors.As(err, &keyErr) {
+			return err
+		}
+		if len(keyErr.Want) == 0 {
+			return fmt.Errorf("host %s is not in %s, add its host key with ssh-keyscan or set verify_git_host_key_fingerprint", host, hostKeys.KnownHostsPath)
+		}
+
+		config.HostKeyAlgorithms = nil
+		for _, known := range keyErr.Want {
+			config.HostKeyAlgorithms = append(config.HostKeyAlgorithms, hostKeyAlgorithms(known.Key.Type())...)
+		}
+		return nil
+	})
+}
+
+// dialGitSSHFingerprint connects to the SSH git remote at host and checks
+// its host key has the given SHA256 fingerprint. The fingerprint does not
+// say which type of key it is for, so each type the server presents is tried
+// until one matches.
+func dialGitSSHFingerprint(ctx context.Context, host string, config *ssh.ClientConfig, fingerprint string) (*ssh.Client, error) {
+	var presented []string
+	var mismatched string
+	var matched bool
+	config.HostKeyCallback = func(_ string, _ net.Addr, key ssh.PublicKey) error {
+		matched = false
+		if got := ssh.FingerprintSHA256(key); got != fingerprint {
+			mismatched = key.Type()
+			presented = append(presented, key.Type()+" "+got)
+			return errors.New("host key fingerprint does not match")
+		}
+		matched = true
+		return nil
+	}
+
+	excluded := make(map[string]bool)
+	for {
+		config.HostKeyAlgorithms = nil
+		for _, algorithm := range supportedHostKeyAlgorithms {
+			if !excluded[algorithm] {
+				config.HostKeyAlgorithms = append(config.HostKeyAlgorithms, algorithm)
+			}
+		}
+		if len(config.HostKeyAlgorithms) == 0 {
+			break
+		}
+
+		mismatched, matched = "", false
+		client, err := dialSSH(ctx, host, config, nil)
+		if err == nil || matched {
+			return client, err
+		}
+		// Only a mismatched key is worth retrying. When the server has no
+		// other type of key, the handshake fails before the callback.
+		if mismatched == "" {
+			if len(presented) == 0 {
+				return nil, err
+			}
+			break
+		}
+		for _, algorithm := range hostKeyAlgorithms(mismatched) {
+			excluded[algorithm] = true
+		}
+	}
+
+	return nil, fmt.Errorf("no host key matches fingerprint %s, the server presented %s", fingerprint, strings.Join(presented, ", "))
+}
+
+// dialSSH connects to the SSH server at host. If set, prepare is called with
+// the remote address once connected, before the SSH handshake.
+func dialSSH(ctx context.Context, host string, config *ssh.ClientConfig, prepare func(net.Addr) error) (*ssh.Client, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	if prepare != nil {
+		if err := prepare(conn.RemoteAddr()); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, host, config)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return ssh.NewClient(sshConn, chans, reqs), nil
+}
+
+// supportedHostKeyAlgorithms are the host key algorithms negotiated with a
+// git remote, in order of preference.
+var supportedHostKeyAlgorithms = []string{
+	ssh.KeyAlgoED25519,
+	ssh.KeyAlgoECDSA256,
+	ssh.KeyAlgoECDSA384,
+	ssh.KeyAlgoECDSA521,
+	ssh.KeyAlgoRSASHA512,
+	ssh.KeyAlgoRSASHA256,
+	ssh.KeyAlgoRSA,
+}
+
+// hostKeyAlgorithms returns the host key algorithms that present a key of
+// the given type.
+func hostKeyAlgorithms(keyType string) []string {
+	if keyType == ssh.KeyAlgoRSA {
+		return []string{ssh.KeyAlgoRSASHA512, ssh.KeyAlgoRSASHA256, ssh.KeyAlgoRSA}
+	}
+	return []string{keyType}
+}
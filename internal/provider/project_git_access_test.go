@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const testGitSHA = "8f7c5e1b2a3d4c6e9f0a1b2c3d4e5f6a7b8c9d0e"
+
+// testPktLine encodes s as a git pkt-line
+func testPktLine(s string) string {
+	return fmt.Sprintf("%04x%s", len(s)+4, s)
+}
+
+// testRefAdvertisement is what git-upload-pack advertises for a repository
+// with a main branch and a v1.0.0 tag.
+var testRefAdvertisement = testPktLine(testGitSHA+" HEAD\x00multi_ack side-band-64k symref=HEAD:refs/heads/main\n") +
+	testPktLine(testGitSHA+" refs/heads/main\n") +
+	testPktLine(testGitSHA+" refs/tags/v1.0.0\n") +
+	"0000"
+
+func TestListGitRefsHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/org/repo.git/info/refs" || r.URL.Query().Get("service") != "git-upload-pack" {
+			http.NotFound(w, r)
+			return
+		}
+		if user, pass, ok := r.BasicAuth(); !ok || user != "waypoint" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-git-upload-pack-advertisement")
+		_, _ = io.WriteString(w, testPktLine("# service=git-upload-pack\n")+"0000"+testRefAdvertisement)
+	}))
+	defer server.Close()
+
+	refs, err := listGitRefs(context.Background(), server.URL+"/org/repo.git", gitCredentials{Username: "waypoint", Password: "secret"}, gitHostKeyCheck{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testCheckRefs(t, refs)
+
+	_, err = listGitRefs(context.Background(), server.URL+"/org/repo.git", gitCredentials{Username: "waypoint", Password: "wrong"}, gitHostKeyCheck{})
+	if err == nil || !strings.Contains(err.Error(), "authentication failed") {
+		t.Fatalf("expected authentication error, got: %v", err)
+	}
+
+	_, err = listGitRefs(context.Background(), server.URL+"/org/missing.git", gitCredentials{Username: "waypoint", Password: "secret"}, gitHostKeyCheck{})
+	if err == nil || !strings.Contains(err.Error(), "repository not found") {
+		t.Fatalf("expected not found error, got: %v", err)
+	}
+}
+
+func TestListGitRefsSSH(t *testing.T) {
+	clientKey, err := ssh.ParsePrivateKey([]byte(testExampleSSHPrivateKey(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr, hostKeys := testGitSSHServer(t, clientKey.PublicKey(), "org/repo.git")
+	creds := gitCredentials{SSHUser: "git", SSHPrivateKey: testExampleSSHPrivateKey(t)}
+	check := gitHostKeyCheck{Fingerprint: ssh.FingerprintSHA256(hostKeys[0])}
+
+	refs, err := listGitRefs(context.Background(), "ssh://"+addr+"/org/repo.git", creds, check)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testCheckRefs(t, refs)
+
+	// A passphrase set for an unencrypted key is ignored
+	creds.SSHPassphrase = "test"
+	refs, err = listGitRefs(context.Background(), "ssh://"+addr+"/org/repo.git", creds, check)
+	if err != nil {
+		t.Fatalf("unexpected error with a passphrase for an unencrypted key: %s", err)
+	}
+	testCheckRefs(t, refs)
+	creds.SSHPassphrase = ""
+
+	_, err = listGitRefs(context.Background(), "ssh://"+addr+"/org/missing.git", creds, check)
+	if err == nil || !strings.Contains(err.Error(), "does not appear to be a git repository") {
+		t.Fatalf("expected missing repository error, got: %v", err)
+	}
+
+	_, err = listGitRefs(context.Background(), "ssh://"+addr+"/org/repo.git", gitCredentials{SSHUser: "git"}, check)
+	if err == nil || !strings.Contains(err.Error(), "unable to authenticate") {
+		t.Fatalf("expected authentication error, got: %v", err)
+	}
+}
+
+func TestListGitRefsSSH_hostKey(t *testing.T) {
+	clientKey, err := ssh.ParsePrivateKey([]byte(testExampleSSHPrivateKey(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr, hostKeys := testGitSSHServer(t, clientKey.PublicKey(), "org/repo.git")
+	creds := gitCredentials{SSHUser: "git", SSHPrivateKey: testExampleSSHPrivateKey(t)}
+	url := "ssh://" + addr + "/org/repo.git"
+
+	// Only the ECDSA key is known, while the server prefers its ed25519 key
+	knownHosts := filepath.Join(t.TempDir(), "known_hosts")
+	if err := os.WriteFile(knownHosts, []byte(knownhosts.Line([]string{addr}, hostKeys[1])+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := listGitRefs(context.Background(), url, creds, gitHostKeyCheck{KnownHostsPath: knownHosts}); err != nil {
+		t.Errorf("expected the known host key to be accepted, got: %s", err)
+	}
+	if _, err := listGitRefs(context.Background(), url, creds, gitHostKeyCheck{Fingerprint: ssh.FingerprintSHA256(hostKeys[1])}); err != nil {
+		t.Errorf("expected the ECDSA fingerprint to be accepted, got: %s", err)
+	}
+
+	unknownHosts := filepath.Join(t.TempDir(), "known_hosts")
+	if err := os.WriteFile(unknownHosts, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	_, err = listGitRefs(context.Background(), url, creds, gitHostKeyCheck{KnownHostsPath: unknownHosts})
+	if err == nil || !strings.Contains(err.Error(), "is not in") {
+		t.Errorf("expected an unknown host error, got: %v", err)
+	}
+
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherSigner, err := ssh.NewSignerFromKey(otherKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = listGitRefs(context.Background(), url, creds, gitHostKeyCheck{Fingerprint: ssh.FingerprintSHA256(otherSigner.PublicKey())})
+	if err == nil || !strings.Contains(err.Error(), "no host key matches") {
+		t.Errorf("expected a fingerprint mismatch error, got: %v", err)
+	}
+
+	if _, err := listGitRefs(context.Background(), url, creds, gitHostKeyCheck{Insecure: true}); err != nil {
+		t.Errorf("expected the host key to be ignored, got: %s", err)
+	}
+}
+
+func TestSplitSSHGitURL(t *testing.T) {
+	cases := map[string][3]string{
+		"git@github.com:org/repo.git":           {"git", "github.com:22", "org/repo.git"},
+		"deploy@example.com:repo.git":           {"deploy", "example.com:22", "repo.git"},
+		"ssh://example.com:2222/org/repo.git":   {"git", "example.com:2222", "/org/repo.git"},
+		"ssh://deploy@example.com/~deploy/r":    {"deploy", "example.com:22", "~deploy/r"},
+		"git+ssh://git@example.com/org/repo":    {"git", "example.com:22", "/org/repo"},
+		"ssh://git@[::1]:2222/org/repo.git":     {"git", "[::1]:2222", "/org/repo.git"},
+		"ssh://git@example.com/org/my repo.git": {"git", "example.com:22", "/org/my repo.git"},
+	}
+
+	for rawURL, want := range cases {
+		user, host, repoPath, err := splitSSHGitURL(rawURL)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %s", rawURL, err)
+			continue
+		}
+		if got := [3]string{user, host, repoPath}; got != want {
+			t.Errorf("expected %v for %q, got %v", want, rawURL, got)
+		}
+	}
+}
+
+func TestGitRefExists(t *testing.T) {
+	refs := map[string]string{
+		"HEAD":             testGitSHA,
+		"refs/heads/main":  testGitSHA,
+		"refs/tags/v1.0.0": testGitSHA,
+	}
+
+	for _, ref := range []string{"", "HEAD", "main", "heads/main", "refs/heads/main", "v1.0.0", "refs/tags/v1.0.0", "8f7c5e1"} {
+		if !gitRefExists(refs, ref) {
+			t.Errorf("expected %q to exist", ref)
+		}
+	}
+	for _, ref := range []string{"develop", "v2.0.0", "refs/heads/v1.0.0", "8f7c"} {
+		if gitRefExists(refs, ref) {
+			t.Errorf("expected %q not to exist", ref)
+		}
+	}
+}
+
+func testCheckRefs(t *testing.T, refs map[string]string) {
+	t.Helper()
+
+	if len(refs) != 3 {
+		t.Fatalf("expected 3 refs, got %v", refs)
+	}
+	for _, name := range []string{"HEAD", "refs/heads/main", "refs/tags/v1.0.0"} {
+		if refs[name] != testGitSHA {
+			t.Errorf("expected %s to be %s, got %q", name, testGitSHA, refs[name])
+		}
+	}
+}
+
+// testGitSSHServer starts an SSH server standing in for a git host, with an
+// ed25519 and an ECDSA host key. It
+// accepts clientKey and answers git-upload-pack for repoPath with
+// testRefAdvertisement.
+func testGitSSHServer(t *testing.T, clientKey ssh.PublicKey, repoPath string) (string, []ssh.PublicKey) {
+	t.Helper()
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hostSigners []ssh.Signer
+	var hostKeys []ssh.PublicKey
+	for _, key := range []interface{}{edKey, ecKey} {
+		signer, err := ssh.NewSignerFromKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hostSigners = append(hostSigners, signer)
+		hostKeys = append(hostKeys, signer.PublicKey())
+	}
+
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if bytes.Equal(key.Marshal(), clientKey.Marshal()) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unknown public key")
+		},
+	}
+	for _, signer := range hostSigners {
+		config.AddHostKey(signer)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go testServeGitSSH(conn, config, repoPath)
+		}
+	}()
+
+	return listener.Addr().String(), hostKeys
+}
+
+func testServeGitSSH(conn net.Conn, config *ssh.ServerConfig, repoPath string) {
+	defer conn.Close()
+
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "unsupported channel type")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			defer channel.Close()
+
+			for req := range requests {
+				if req.Type != "exec" {
+					_ = req.Reply(false, nil)
+					continue
+				}
+				_ = req.Reply(true, nil)
+
+				var exec struct{ Command string }
+				_ = ssh.Unmarshal(req.Payload, &exec)
+
+				exitStatus := uint32(0)
+				if exec.Command == "git-upload-pack '/"+repoPath+"'" {
+					_, _ = io.WriteString(channel, testRefAdvertisement)
+					// Wait for the client to hang up with a flush packet
+					_, _ = io.ReadFull(channel, make([]byte, 4))
+				} else {
+					_, _ = io.WriteString(channel.Stderr(), "fatal: '"+strings.TrimPrefix(exec.Command, "git-upload-pack ")+"' does not appear to be a git repository\n")
+					exitStatus = 128
+				}
+
+				_, _ = channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{exitStatus}))
+				return
+			}
+		}()
+	}
+}
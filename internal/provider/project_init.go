@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultInitTimeout is how long to wait for a project init job when
+	// init_timeout is not set.
+	defaultInitTimeout = 5 * time.Minute
+
+	// jobPollInterval is how often a queued job is checked for completion.
+	jobPollInterval = 2 * time.Second
+)
+
+// runInit queues an init job for the project against its git data source,
+// the same job the project poller runs, and waits for it to finish. Once
+// it succeeds, the applications defined in waypoint.hcl are registered on
+// the server and plan.Applications is refreshed with them.
+func (r *projectResource) runInit(ctx context.Context, plan *projectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	projectName := plan.Name.ValueString()
+
+	timeout := defaultInitTimeout
+	if !plan.InitTimeout.IsNull() {
+		var d diag.Diagnostics
+		timeout, d = plan.InitTimeout.ValueGoDuration()
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	tflog.Info(ctx, "Queueing project init job")
+	queued, err := r.client.GRPCClient().QueueJob(ctx, &gen.QueueJobRequest{
+		Job: &gen.Job{
+			Application: &gen.Ref_Application{Project: projectName},
+			Workspace:   &gen.Ref_Workspace{Workspace: defaultWorkspace},
+			TargetRunner: &gen.Ref_Runner{
+				Target: &gen.Ref_Runner_Any{Any: &gen.Ref_RunnerAny{}},
+			},
+			Operation: &gen.Job_Init{Init: &gen.Job_InitOp{}},
+		},
+		// Cancel the job if no runner picks it up before we stop waiting
+		ExpiresIn: timeout.String(),
+	})
+	if err != nil {
+		diags.AddError(
+			"Error Initializing Project",
+			"Could not queue init job for project "+projectName+": "+err.Error(),
+		)
+		return diags
+	}
+
+	ctx = tflog.SetField(ctx, "waypoint_job_id", queued.JobId)
+	if err := waitForJob(ctx, r.client.GRPCClient(), queued.JobId); err != nil {
+		diags.AddError(
+			"Error Initializing Project",
+			"Init job "+queued.JobId+" for project "+projectName+" did not succeed: "+err.Error(),
+		)
+		return diags
+	}
+
+	project, err := r.client.GetProject(ctx, projectName)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Project",
+			"Could not read Project with name "+projectName+": "+err.Error(),
+		)
+		return diags
+	}
+
+	plan.Applications, diags = flattenApplications(ctx, project.Applications)
+	return diags
+}
+
+// waitForJob polls a queued job until it either succeeds or fails, or ctx is
+// done.
+func waitForJob(ctx context.Context, client gen.WaypointClient, jobID string) error {
+	ticker := time.NewTicker(jobPollInterval)
+	defer ticker.Stop()
+
+	for {
+		job, err := client.GetJob(ctx, &gen.GetJobRequest{JobId: jobID})
+		if err != nil {
+			return err
+		}
+
+		tflog.Debug(ctx, "Checked job state", map[string]interface{}{"state": job.State.String()})
+		switch job.State {
+		case gen.Job_SUCCESS:
+			return nil
+		case gen.Job_ERROR:
+			if job.Error != nil {
+				return fmt.Errorf("job failed: %s", job.Error.Message)
+			}
+			return fmt.Errorf("job failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for job in state %s: %w", job.State, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// flattenApplications converts the applications of a project to a list of
+// application names.
+func flattenApplications(ctx context.Context, apps []*gen.Application) (types.List, diag.Diagnostics) {
+	names := make([]string, 0, len(apps))
+	for _, app := range apps {
+		names = append(names, app.GetName())
+	}
+
+	return types.ListValueFrom(ctx, types.StringType, names)
+}
@@ -5,17 +5,26 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"regexp"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-waypoint/internal/defaults"
+	"github.com/hashicorp/terraform-provider-waypoint/internal/timetypes"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -25,10 +34,27 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &projectResource{}
-	_ resource.ResourceWithConfigure = &projectResource{}
+	_ resource.Resource                   = &projectResource{}
+	_ resource.ResourceWithConfigure      = &projectResource{}
+	_ resource.ResourceWithValidateConfig = &projectResource{}
+	_ resource.ResourceWithUpgradeState   = &projectResource{}
+	_ resource.ResourceWithModifyPlan     = &projectResource{}
 )
 
+const (
+	// destroyBehaviorDestroy deletes the project on the server when the
+	// resource is destroyed.
+	destroyBehaviorDestroy = "destroy"
+
+	// destroyBehaviorAbandon only removes the project from Terraform state,
+	// leaving it and its deployments on the server.
+	destroyBehaviorAbandon = "abandon"
+)
+
+// minPollInterval is the shortest interval accepted for git and application
+// status polling.
+const minPollInterval = time.Second
+
 func NewProjectResource() resource.Resource {
 	return &projectResource{}
 }
@@ -39,32 +65,45 @@ type projectResource struct {
 
 // projectResourceModel maps the resource schema data.
 type projectResourceModel struct {
-	ID                   types.String      `tfsdk:"id"`
-	Name                 types.String      `tfsdk:"project_name"`
-	Variables            []*variablesModel `tfsdk:"project_variables"`
-	RemoteRunnersEnabled types.Bool        `tfsdk:"remote_runners_enabled"`
-	AppStatusPollSeconds types.Int64       `tfsdk:"app_status_poll_seconds"`
+	ID                   types.String               `tfsdk:"id"`
+	Name                 types.String               `tfsdk:"project_name"`
+	Variables            map[string]*variablesModel `tfsdk:"variables"`
+	RemoteRunnersEnabled types.Bool                 `tfsdk:"remote_runners_enabled"`
+	AppStatusPoll        timetypes.GoDuration       `tfsdk:"app_status_poll_interval"`
+	AppStatusPollEnabled types.Bool                 `tfsdk:"app_status_polling_enabled"`
+	RunInitOnCreate      types.Bool                 `tfsdk:"run_init_on_create"`
+	RunInitOnChange      types.Bool                 `tfsdk:"run_init_on_change"`
+	InitTimeout          timetypes.GoDuration       `tfsdk:"init_timeout"`
+	Applications         types.List                 `tfsdk:"applications"`
+	DeletionProtection   types.Bool                 `tfsdk:"deletion_protection"`
+	DestroyBehavior      types.String               `tfsdk:"destroy_behavior"`
+	VerifyGitAccess      types.Bool                 `tfsdk:"verify_git_access"`
+
+	VerifyGitHostKeyFingerprint    types.String `tfsdk:"verify_git_host_key_fingerprint"`
+	VerifyGitInsecureIgnoreHostKey types.Bool   `tfsdk:"verify_git_insecure_ignore_host_key"`
 
 	DataSourceGit *dataSourceGitModel `tfsdk:"data_source_git"`
 	GitAuthBasic  *gitAuthBasicModel  `tfsdk:"git_auth_basic"`
 	GitAuthSSH    *gitAuthSSHModel    `tfsdk:"git_auth_ssh"`
 }
 
-// variablesModel map variables
+// variablesModel map variables, keyed by variable name
 type variablesModel struct {
-	Name      types.String `tfsdk:"name"`
-	Value     types.String `tfsdk:"value"`
-	Sensitive types.Bool   `tfsdk:"sensitive"`
+	Value          types.String `tfsdk:"value"`
+	SensitiveValue types.String `tfsdk:"sensitive_value"`
+	Sensitive      types.Bool   `tfsdk:"sensitive"`
 }
 
 // dataSourceGitModel map git information
 type dataSourceGitModel struct {
-	Url                      types.String `tfsdk:"git_url"`
-	Path                     types.String `tfsdk:"git_path"`
-	Ref                      types.String `tfsdk:"git_ref"`
-	IgnoreChangesOutsidePath types.Bool   `tfsdk:"ignore_changes_outside_path"`
-	PollInterval             types.Int64  `tfsdk:"git_poll_interval_seconds"`
-	FileChangeSignal         types.String `tfsdk:"file_change_signal"`
+	Url                      types.String         `tfsdk:"git_url"`
+	Path                     types.String         `tfsdk:"git_path"`
+	Ref                      types.String         `tfsdk:"git_ref"`
+	IgnoreChangesOutsidePath types.Bool           `tfsdk:"ignore_changes_outside_path"`
+	PollInterval             timetypes.GoDuration `tfsdk:"git_poll_interval"`
+	PollingEnabled           types.Bool           `tfsdk:"git_polling_enabled"`
+	RecurseSubmodules        types.Int64          `tfsdk:"recurse_submodules"`
+	FileChangeSignal         types.String         `tfsdk:"file_change_signal"`
 }
 
 // gitAuthBasicModel maps git auth data
@@ -73,7 +112,9 @@ type gitAuthBasicModel struct {
 	Password types.String `tfsdk:"password"`
 }
 
-// gitAuthSSHModel maps git auth ssh data
+// gitAuthSSHModel maps git auth ssh data. The Waypoint API only accepts a
+// user, private key and passphrase for SSH, so there is nowhere to send a
+// known_hosts entry or host key fingerprint for the server to pin.
 type gitAuthSSHModel struct {
 	User       types.String `tfsdk:"git_user"`
 	Passphrase types.String `tfsdk:"passphrase"`
@@ -92,26 +133,43 @@ func (r *projectResource) Configure(_ context.Context, req resource.ConfigureReq
 // Schema defines the schema for the resource.
 func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The id required for acceptance testing to work",
 			},
 			"project_name": schema.StringAttribute{
-				Required:    true,
-				Description: "The name of the Waypoint project",
+				Required: true,
+				// Waypoint identifies projects by name and has no rename, so
+				// upserting under a new name would orphan the old project.
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The name of the Waypoint project. Waypoint cannot rename projects, so changing this destroys the project and creates a new one",
 			},
-			"project_variables": schema.ListNestedAttribute{
+			"variables": schema.MapNestedAttribute{
 				Optional:    true,
-				Description: "List of variables in Key/value pairs associated with the Waypoint Project",
+				Description: "Variables associated with the Waypoint Project, keyed by variable name",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Required: true},
 						"value": schema.StringAttribute{
-							Required: true},
+							Optional:    true,
+							Description: "Value of the variable, shown in plan output",
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.Expressions{
+									path.MatchRelative().AtParent().AtName("sensitive_value"),
+								}...),
+							},
+						},
+						"sensitive_value": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Value of a sensitive variable, redacted in plan output. Setting this marks the variable as sensitive",
+						},
 						"sensitive": schema.BoolAttribute{
-							Optional: true,
+							Optional:    true,
+							Description: "Whether the variable is sensitive on the Waypoint server",
 						},
 					},
 				},
@@ -121,7 +179,7 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "Configuration of Git repository where waypoint.hcl file is stored",
 				Attributes: map[string]schema.Attribute{
 					"git_url": &schema.StringAttribute{
-						Optional:    true,
+						Required:    true,
 						Description: "Url of git repository storing the waypoint.hcl file",
 					},
 					"git_path": &schema.StringAttribute{
@@ -140,9 +198,24 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 						},
 						Description: "Whether Waypoint ignores changes outside path storing waypoint.hcl file",
 					},
-					"git_poll_interval_seconds": &schema.Int64Attribute{
+					"git_poll_interval": &schema.StringAttribute{
+						CustomType:  timetypes.GoDurationType{},
 						Optional:    true,
-						Description: "Interval at which Waypoint should poll git repository for changes",
+						Description: "Interval at which Waypoint should poll git repository for changes, as a duration such as `30s` or `5m`",
+						Validators: []validator.String{
+							timetypes.GoDurationAtLeast(minPollInterval),
+						},
+					},
+					"git_polling_enabled": &schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether Waypoint polls the git repository for changes. Defaults to enabled when `git_poll_interval` is set",
+					},
+					"recurse_submodules": &schema.Int64Attribute{
+						Optional:    true,
+						Description: "Maximum depth of submodules to clone recursively. Submodules are not cloned when this is unset or 0",
+						Validators: []validator.Int64{
+							int64validator.Between(0, math.MaxUint32),
+						},
 					},
 					"file_change_signal": &schema.StringAttribute{
 						Optional:    true,
@@ -178,7 +251,7 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 						path.MatchRoot("git_auth_basic"),
 					}...),
 				},
-				Description: "SSH authentication details for Git",
+				Description: "SSH authentication details for Git. The Waypoint API has no setting for pinning the git host key, so known hosts cannot be configured",
 				Attributes: map[string]schema.Attribute{
 					"git_user": &schema.StringAttribute{
 						Optional:    true,
@@ -196,10 +269,78 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					},
 				},
 			},
-			"app_status_poll_seconds": &schema.Int64Attribute{
+			"app_status_poll_interval": &schema.StringAttribute{
+				CustomType:  timetypes.GoDurationType{},
+				Optional:    true,
+				Computed:    true,
+				Description: "Application status poll interval, as a duration such as `30s` or `5m`",
+				Validators: []validator.String{
+					timetypes.GoDurationAtLeast(minPollInterval),
+				},
+			},
+			"app_status_polling_enabled": &schema.BoolAttribute{
 				Optional:    true,
+				Description: "Whether Waypoint polls for application status reports. Defaults to enabled when `app_status_poll_interval` is greater than zero",
+			},
+			"run_init_on_create": &schema.BoolAttribute{
+				Optional:    true,
+				Description: "Run an init job against the git data source after creating the project and wait for it to finish, so the applications in waypoint.hcl exist once apply completes. The job needs a runner to be available",
+			},
+			"run_init_on_change": &schema.BoolAttribute{
+				Optional:    true,
+				Description: "Run an init job against the git data source after each update of the project and wait for it to finish",
+			},
+			"init_timeout": &schema.StringAttribute{
+				CustomType:  timetypes.GoDurationType{},
+				Optional:    true,
+				Description: "How long to wait for an init job to finish, as a duration such as `30s` or `5m`. Defaults to `5m`",
+				Validators: []validator.String{
+					timetypes.GoDurationAtLeast(time.Second),
+				},
+			},
+			"applications": schema.ListAttribute{
 				Computed:    true,
-				Description: "Application status poll interval in seconds",
+				Description: "List of applications for this project",
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					defaults.BoolDefaultValue(types.BoolValue(false)),
+				},
+				Description: "Prevent Terraform from destroying the project, and with it all of its history. Must be set to false and applied before the project can be destroyed",
+			},
+			"destroy_behavior": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					defaults.StringDefaultValue(types.StringValue(destroyBehaviorDestroy)),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(destroyBehaviorDestroy, destroyBehaviorAbandon),
+				},
+				Description: "What happens to the project when the resource is destroyed. `destroy` deletes the project on the server. `abandon` only removes it from Terraform state, leaving its applications and deployments running. Defaults to `destroy`",
+			},
+			"verify_git_access": schema.BoolAttribute{
+				Optional: true,
+				Description: "Before creating or updating the project, list the refs of `git_url` with the configured credentials, like `git ls-remote`, and fail if the repository or `git_ref` cannot be reached. The check runs from where Terraform runs, not from the Waypoint server. " +
+					"For SSH URLs, the host key is verified against `verify_git_host_key_fingerprint` when set, and otherwise must be in `~/.ssh/known_hosts`",
+			},
+			"verify_git_host_key_fingerprint": schema.StringAttribute{
+				Optional:    true,
+				Description: "SHA256 fingerprint of the SSH host key of the git server, as printed by `ssh-keygen -lf`, such as `SHA256:+DiY3wvvV6TuJJhbpZisF/zLDA0zPMSvHdkr4UvCOqU`. Used by `verify_git_access` instead of `~/.ssh/known_hosts`",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^SHA256:[A-Za-z0-9+/]{43}$`), "must be a SHA256 fingerprint such as SHA256:+DiY3wvvV6TuJJhbpZisF/zLDA0zPMSvHdkr4UvCOqU"),
+					stringvalidator.ConflictsWith(path.MatchRoot("verify_git_insecure_ignore_host_key")),
+				},
+			},
+			"verify_git_insecure_ignore_host_key": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip verifying the SSH host key of the git server in `verify_git_access`. An attacker in the middle could then answer the check, so it no longer shows the real repository is reachable. Only use it when the host key cannot be verified",
 			},
 		},
 	}
@@ -216,6 +357,13 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	if plan.VerifyGitAccess.ValueBool() {
+		resp.Diagnostics.Append(verifyGitAccess(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var err error
 	plan, err = r.upsert(ctx, plan)
 	if err != nil {
@@ -226,6 +374,14 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	// The project exists at this point, so a failed init leaves it in state
+	// to be replaced on the next apply rather than orphaned on the server.
+	if plan.RunInitOnCreate.ValueBool() {
+		resp.Diagnostics.Append(r.runInit(ctx, &plan)...)
+	}
+
+	resp.Diagnostics.Append(setVariableHashes(ctx, resp.Private, plan.Variables)...)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -234,6 +390,26 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 }
 
+// ModifyPlan marks the applications unknown when an update runs an init job,
+// which can add or remove applications. Otherwise they are kept from state.
+func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Creating the project leaves the applications unknown, and there is
+	// nothing to plan when destroying it or when nothing changed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var runInit types.Bool
+	diags := req.Plan.GetAttribute(ctx, path.Root("run_init_on_change"), &runInit)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !runInit.ValueBool() {
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("applications"), types.ListUnknown(types.StringType))
+	resp.Diagnostics.Append(diags...)
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
@@ -267,21 +443,40 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.ID = types.StringValue(project.Name) //name is the best we can do for now
 	state.RemoteRunnersEnabled = types.BoolValue(project.RemoteEnabled)
 
-	var projectVariables []*variablesModel
-	for _, v := range project.Variables {
-		pvar := variablesModel{}
-		pvar.Name = types.StringValue(v.Name)
-		// we only support string values(?)
-		str := v.Value.(*gen.Variable_Str).Str
-		pvar.Value = types.StringValue(str)
-		pvar.Sensitive = types.BoolValue(v.Sensitive)
-		projectVariables = append(projectVariables, &pvar)
+	// deletion_protection and destroy_behavior only exist in Terraform state
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+	if state.DestroyBehavior.IsNull() {
+		state.DestroyBehavior = types.StringValue(destroyBehaviorDestroy)
+	}
+
+	state.Applications, diags = flattenApplications(ctx, project.Applications)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	state.Variables = projectVariables
+
+	hashes, diags := getVariableHashes(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Variables = flattenProjectVariables(project.Variables, state.Variables, hashes)
+	resp.Diagnostics.Append(setVariableHashes(ctx, resp.Private, state.Variables)...)
 
 	// TODO: This should be a &gen.Job_DataSource_Git from the protos. Not yet
 	// sure what to do here if it's a _Local or _Remote version of that
 	// dataSource := project.DataSource.Source
+	priorGitPoll := timetypes.NewGoDurationNull()
+	priorGitPollEnabled := types.BoolNull()
+	priorRecurseSubmodules := types.Int64Null()
+	if state.DataSourceGit != nil {
+		priorGitPoll = state.DataSourceGit.PollInterval
+		priorGitPollEnabled = state.DataSourceGit.PollingEnabled
+		priorRecurseSubmodules = state.DataSourceGit.RecurseSubmodules
+	}
+
 	var dsg *dataSourceGitModel
 	var gab *gitAuthBasicModel
 	var gas *gitAuthSSHModel
@@ -292,14 +487,20 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		default:
 			// assumes *gen.Job_DataSource_Git
 			src := project.DataSource.Source.(*gen.Job_DataSource_Git)
-			pollRaw, _ := time.ParseDuration(project.DataSourcePoll.Interval)
-			poll := pollRaw / time.Second
+			poll, diags := flattenPollInterval(ctx, priorGitPoll, project.DataSourcePoll.GetInterval())
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			pollEnabled := flattenPollEnabled(priorGitPollEnabled, project.DataSourcePoll.GetEnabled(), poll)
 			dsg = &dataSourceGitModel{
 				Url:                      types.StringValue(src.Git.Url),
 				Ref:                      types.StringValue(src.Git.Ref),
 				Path:                     types.StringValue(src.Git.Path),
 				IgnoreChangesOutsidePath: types.BoolValue(src.Git.IgnoreChangesOutsidePath),
-				PollInterval:             types.Int64Value(int64(poll)),
+				PollInterval:             poll,
+				PollingEnabled:           pollEnabled,
+				RecurseSubmodules:        flattenRecurseSubmodules(priorRecurseSubmodules, src.Git.RecurseSubmodules),
 				FileChangeSignal:         types.StringValue(project.FileChangeSignal),
 			}
 
@@ -322,9 +523,12 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.GitAuthSSH = gas
 
 	if project.StatusReportPoll != nil {
-		pollRaw, _ := time.ParseDuration(project.StatusReportPoll.Interval)
-		poll := pollRaw / time.Second
-		state.AppStatusPollSeconds = types.Int64Value(int64(poll))
+		state.AppStatusPoll, diags = flattenPollInterval(ctx, state.AppStatusPoll, project.StatusReportPoll.Interval)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.AppStatusPollEnabled = flattenPollEnabled(state.AppStatusPollEnabled, project.StatusReportPoll.Enabled, state.AppStatusPoll)
 	}
 
 	// Set refreshed state
@@ -348,6 +552,27 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 	projectName := state.Name.ValueString()
 	ctx = tflog.SetField(ctx, "waypoint_project", projectName)
 
+	// Abandoning leaves the project untouched, so deletion protection does
+	// not need to stop it.
+	if state.DestroyBehavior.ValueString() == destroyBehaviorAbandon {
+		tflog.Info(ctx, "Abandoning project, removing from state only")
+		resp.Diagnostics.AddWarning(
+			"Waypoint Project Abandoned",
+			"Project "+projectName+" was removed from Terraform state but still exists on the Waypoint server, "+
+				"along with its applications and deployments.",
+		)
+		return
+	}
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Error Deleting Waypoint Project",
+			"Project "+projectName+" has deletion_protection enabled. "+
+				"Set deletion_protection to false and apply the change before destroying it.",
+		)
+		return
+	}
+
 	// Delete existing project
 	err := r.client.DestroyProject(ctx, state.Name.ValueString())
 	if err != nil {
@@ -375,6 +600,13 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if plan.VerifyGitAccess.ValueBool() {
+		resp.Diagnostics.Append(verifyGitAccess(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var err error
 	plan, err = r.upsert(ctx, plan)
 	if err != nil {
@@ -385,6 +617,12 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if plan.RunInitOnChange.ValueBool() {
+		resp.Diagnostics.Append(r.runInit(ctx, &plan)...)
+	}
+
+	resp.Diagnostics.Append(setVariableHashes(ctx, resp.Private, plan.Variables)...)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -397,63 +635,206 @@ func (r *projectResource) upsert(ctx context.Context, plan projectResourceModel)
 	projectName := plan.Name.ValueString()
 	ctx = tflog.SetField(ctx, "waypoint_project", projectName)
 
-	projectConf := waypointClient.DefaultProjectConfig()
-
 	// Git configuration for Waypoint project
-	gitConfig := waypointClient.Git{
+	gitConfig := &gen.Job_Git{
 		Url:                      plan.DataSourceGit.Url.ValueString(),
 		Path:                     plan.DataSourceGit.Path.ValueString(),
 		IgnoreChangesOutsidePath: plan.DataSourceGit.IgnoreChangesOutsidePath.ValueBool(),
 		Ref:                      plan.DataSourceGit.Ref.ValueString(),
+		RecurseSubmodules:        uint32(plan.DataSourceGit.RecurseSubmodules.ValueInt64()),
 	}
 
 	if plan.GitAuthBasic != nil {
-		auth := &waypointClient.GitAuthBasic{
-			Username: plan.GitAuthBasic.Username.ValueString(),
-			Password: plan.GitAuthBasic.Password.ValueString(),
+		gitConfig.Auth = &gen.Job_Git_Basic_{
+			Basic: &gen.Job_Git_Basic{
+				Username: plan.GitAuthBasic.Username.ValueString(),
+				Password: plan.GitAuthBasic.Password.ValueString(),
+			},
 		}
-		gitConfig.Auth = auth
 	} else if plan.GitAuthSSH != nil {
-		gitUser := plan.GitAuthSSH.User.ValueString()
-		sshPrivateKey := plan.GitAuthSSH.PrivateKey.ValueString()
-		var passphrase string
-		if !plan.GitAuthSSH.Passphrase.IsNull() {
-			passphrase = plan.GitAuthSSH.Passphrase.ValueString()
+		gitConfig.Auth = &gen.Job_Git_Ssh{
+			Ssh: &gen.Job_Git_SSH{
+				User:          plan.GitAuthSSH.User.ValueString(),
+				PrivateKeyPem: []byte(plan.GitAuthSSH.PrivateKey.ValueString()),
+				Password:      plan.GitAuthSSH.Passphrase.ValueString(),
+			},
 		}
+	}
+
+	statusReportPoll, diags := plan.AppStatusPoll.ValueGoDuration()
+	if diags.HasError() {
+		return plan, fmt.Errorf("invalid app_status_poll_interval: %s", plan.AppStatusPoll.ValueString())
+	}
+	gitPollInterval, diags := plan.DataSourceGit.PollInterval.ValueGoDuration()
+	if diags.HasError() {
+		return plan, fmt.Errorf("invalid git_poll_interval: %s", plan.DataSourceGit.PollInterval.ValueString())
+	}
+
+	// The project is sent to the server directly rather than through the
+	// client's UpsertProject, which always derives whether polling is enabled
+	// from the interval.
+	project := &gen.Project{
+		Name:          projectName,
+		RemoteEnabled: plan.RemoteRunnersEnabled.ValueBool(),
+		DataSource: &gen.Job_DataSource{
+			Source: &gen.Job_DataSource_Git{Git: gitConfig},
+		},
+		DataSourcePoll: &gen.Project_Poll{
+			Enabled:  pollEnabled(plan.DataSourceGit.PollingEnabled, gitPollInterval),
+			Interval: gitPollInterval.String(),
+		},
+		StatusReportPoll: &gen.Project_AppStatusPoll{
+			Enabled:  pollEnabled(plan.AppStatusPollEnabled, statusReportPoll),
+			Interval: statusReportPoll.String(),
+		},
+		FileChangeSignal: plan.DataSourceGit.FileChangeSignal.ValueString(),
+		Variables:        expandProjectVariables(plan.Variables),
+	}
 
-		auth := &waypointClient.GitAuthSsh{
-			User:          gitUser,
-			PrivateKeyPem: []byte(sshPrivateKey),
-			Password:      passphrase,
+	resp, err := r.client.GRPCClient().UpsertProject(ctx, &gen.UpsertProjectRequest{Project: project})
+	if err != nil {
+		return plan, err
+	}
+
+	plan.ID = types.StringValue(resp.Project.Name)
+
+	// An interval left out of the configuration is planned as unknown and
+	// takes whatever the server settled on, which is null unless the server
+	// applied an interval of its own.
+	prior := plan.AppStatusPoll
+	if prior.IsUnknown() {
+		prior = timetypes.NewGoDurationNull()
+	}
+	plan.AppStatusPoll, diags = flattenPollInterval(ctx, prior, resp.Project.GetStatusReportPoll().GetInterval())
+	if diags.HasError() {
+		return plan, fmt.Errorf("could not read app_status_poll_interval of project %s", projectName)
+	}
+
+	apps, diags := flattenApplications(ctx, resp.Project.Applications)
+	if diags.HasError() {
+		return plan, fmt.Errorf("could not read applications of project %s", projectName)
+	}
+	plan.Applications = apps
+
+	return plan, nil
+}
+
+func (r *projectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data projectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateProjectGit(data)...)
+
+	// Polling has nothing to run on without an interval, which the server
+	// would otherwise reject or treat as a zero interval.
+	if data.DataSourceGit != nil && data.DataSourceGit.PollingEnabled.ValueBool() && data.DataSourceGit.PollInterval.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("data_source_git").AtName("git_poll_interval"),
+			"Missing Attribute Configuration",
+			"git_poll_interval must be set when git_polling_enabled is true.",
+		)
+	}
+
+	if data.AppStatusPollEnabled.ValueBool() && data.AppStatusPoll.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("app_status_poll_interval"),
+			"Missing Attribute Configuration",
+			"app_status_poll_interval must be set when app_status_polling_enabled is true.",
+		)
+	}
+
+	for name, v := range data.Variables {
+		// Terraform can only redact whole attributes, so a sensitive variable
+		// set through value would still be shown in plan output.
+		if v.Sensitive.ValueBool() && !v.Value.IsNull() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("variables").AtMapKey(name).AtName("value"),
+				"Sensitive Variable Shown In Plan Output",
+				"Variable "+name+" is marked sensitive but is set with value, which is displayed in plan output. "+
+					"Use sensitive_value instead to redact it.",
+			)
 		}
-		gitConfig.Auth = auth
 
-	} else {
-		gitConfig.Auth = nil
+		if !v.Sensitive.IsNull() && !v.Sensitive.ValueBool() && !v.SensitiveValue.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("variables").AtMapKey(name).AtName("sensitive"),
+				"Invalid Attribute Combination",
+				"Variable "+name+" sets sensitive_value, which is always stored as sensitive. "+
+					"Remove sensitive = false or use value instead.",
+			)
+		}
 	}
+}
 
-	// // Project variables configuration
-	var variableList []*gen.Variable
+// flattenPollInterval converts a poll interval returned by the Waypoint server.
+// The server formats durations its own way, e.g. "5m0s" for "5m", so prior is
+// kept when it holds the same duration. A zero interval is left unset when
+// prior was never configured.
+func flattenPollInterval(ctx context.Context, prior timetypes.GoDuration, interval string) (timetypes.GoDuration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var d time.Duration
+	if interval != "" {
+		var err error
+		d, err = time.ParseDuration(interval)
+		if err != nil {
+			diags.AddError(
+				"Error Reading Project",
+				"Could not parse poll interval "+interval+" returned by the Waypoint server: "+err.Error(),
+			)
+			return prior, diags
+		}
+	}
 
-	for _, variable := range plan.Variables {
-		projectVariable := waypointClient.SetVariable()
-		projectVariable.Name = variable.Name.ValueString()
-		projectVariable.Value = &gen.Variable_Str{Str: variable.Value.ValueString()}
-		projectVariable.Sensitive = variable.Sensitive.ValueBool()
-		variableList = append(variableList, &projectVariable)
+	if d == 0 && prior.IsNull() {
+		return prior, diags
 	}
 
-	// Project config for request
-	projectConf.Name = plan.Name.ValueString()
-	projectConf.RemoteRunnersEnabled = plan.RemoteRunnersEnabled.ValueBool()
+	value := timetypes.NewGoDurationTimeDuration(d)
+	equal, diags := prior.StringSemanticEquals(ctx, value)
+	if equal {
+		return prior, diags
+	}
+
+	return value, diags
+}
+
+// pollEnabled reports whether polling should be enabled on the server. An
+// explicit enabled flag wins, otherwise polling is on whenever an interval is
+// set.
+func pollEnabled(enabled types.Bool, interval time.Duration) bool {
+	if enabled.IsNull() || enabled.IsUnknown() {
+		return interval > 0
+	}
 
-	// TODO: verify this is the correct value being sent if value is not
-	// specified, defaulted, etc.
-	projectConf.StatusReportPoll = time.Duration(plan.AppStatusPollSeconds.ValueInt64()) * time.Second
-	projectConf.GitPollInterval = time.Duration(plan.DataSourceGit.PollInterval.ValueInt64()) * time.Second
+	return enabled.ValueBool()
+}
+
+// flattenPollEnabled converts whether polling is enabled on the server. It is
+// left unset when prior was never configured and the server agrees with what
+// the interval implies.
+func flattenPollEnabled(prior types.Bool, enabled bool, interval timetypes.GoDuration) types.Bool {
+	if prior.IsNull() {
+		d, diags := interval.ValueGoDuration()
+		if !diags.HasError() && enabled == (d > 0) {
+			return prior
+		}
+	}
+
+	return types.BoolValue(enabled)
+}
+
+// flattenRecurseSubmodules converts the submodule depth returned by the
+// Waypoint server, leaving it unset when it was never configured and
+// submodules are not cloned.
+func flattenRecurseSubmodules(prior types.Int64, depth uint32) types.Int64 {
+	if prior.IsNull() && depth == 0 {
+		return prior
+	}
 
-	projectConf.FileChangeSignal = plan.DataSourceGit.FileChangeSignal.ValueString()
-	proj, err := r.client.UpsertProject(ctx, projectConf, &gitConfig, variableList)
-	plan.ID = types.StringValue(proj.Name)
-	return plan, err
+	return types.Int64Value(int64(depth))
 }
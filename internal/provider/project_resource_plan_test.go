@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProjectResourceModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := NewProjectResource().(*projectResource)
+	s := testResourceSchema(r)
+
+	apps := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "api"),
+	})
+	state := testResourceValue(s, map[string]tftypes.Value{
+		"project_name":       tftypes.NewValue(tftypes.String, "example"),
+		"applications":       apps,
+		"run_init_on_change": tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	cases := map[string]struct {
+		runInit     bool
+		rename      bool
+		wantUnknown bool
+	}{
+		"no changes":       {runInit: true},
+		"change":           {rename: true},
+		"change with init": {runInit: true, rename: true, wantUnknown: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			projectName := "example"
+			if tc.rename {
+				projectName = "renamed"
+			}
+			planned := testResourceValue(s, map[string]tftypes.Value{
+				"project_name":       tftypes.NewValue(tftypes.String, projectName),
+				"applications":       apps,
+				"run_init_on_change": tftypes.NewValue(tftypes.Bool, tc.runInit),
+			})
+			if !tc.rename {
+				// Without changes the plan matches state
+				planned = state
+			}
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: s, Raw: state},
+				Plan:  tfsdk.Plan{Schema: s, Raw: planned},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			var got types.List
+			resp.Plan.GetAttribute(ctx, path.Root("applications"), &got)
+			if got.IsUnknown() != tc.wantUnknown {
+				t.Errorf("expected applications unknown to be %t, got %v", tc.wantUnknown, got)
+			}
+		})
+	}
+}
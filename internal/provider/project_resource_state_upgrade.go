@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// UpgradeState upgrades state written by prior versions of the project
+// resource schema. The upgrader works on the raw JSON state, so the older
+// schema doesn't need to be redeclared.
+func (r *projectResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored variables in the ordered project_variables list,
+		// and poll intervals as a number of seconds
+		0: {
+			StateUpgrader: upgradeProjectStateV0,
+		},
+	}
+}
+
+// upgradeProjectStateV0 decodes the raw version 0 state, rewrites its
+// variables and poll intervals, and sets the result as the upgraded state.
+func upgradeProjectStateV0(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Project State",
+			"The prior project state is not in JSON format, which is required to upgrade it.",
+		)
+		return
+	}
+
+	var state map[string]json.RawMessage
+	if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Project State",
+			"Could not decode prior project state: "+err.Error(),
+		)
+		return
+	}
+
+	err := upgradeProjectVariablesV0(state)
+	if err == nil {
+		err = upgradePollIntervalsV0(state)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Project State",
+			"Could not upgrade prior project state: "+err.Error(),
+		)
+		return
+	}
+
+	raw, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Project State",
+			"Could not encode upgraded project state: "+err.Error(),
+		)
+		return
+	}
+
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: raw}
+}
+
+// upgradeProjectVariablesV0 moves the project_variables list into the
+// variables map, keyed by each variable's name.
+func upgradeProjectVariablesV0(state map[string]json.RawMessage) error {
+	raw, ok := state["project_variables"]
+	delete(state, "project_variables")
+	if !ok {
+		return nil
+	}
+
+	var list []map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return err
+	}
+
+	if list == nil {
+		return nil
+	}
+
+	variables := make(map[string]map[string]json.RawMessage, len(list))
+	for _, v := range list {
+		var name string
+		if err := json.Unmarshal(v["name"], &name); err != nil {
+			return err
+		}
+
+		delete(v, "name")
+		variables[name] = v
+	}
+
+	mapped, err := json.Marshal(variables)
+	if err != nil {
+		return err
+	}
+
+	state["variables"] = mapped
+	return nil
+}
+
+// upgradePollIntervalsV0 converts git_poll_interval_seconds and
+// app_status_poll_seconds to the git_poll_interval and
+// app_status_poll_interval durations.
+func upgradePollIntervalsV0(state map[string]json.RawMessage) error {
+	interval, err := secondsToDuration(state["app_status_poll_seconds"])
+	if err != nil {
+		return err
+	}
+	delete(state, "app_status_poll_seconds")
+	state["app_status_poll_interval"] = interval
+
+	raw, ok := state["data_source_git"]
+	if !ok {
+		return nil
+	}
+
+	var git map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &git); err != nil {
+		return err
+	}
+
+	if git == nil {
+		return nil
+	}
+
+	interval, err = secondsToDuration(git["git_poll_interval_seconds"])
+	if err != nil {
+		return err
+	}
+	delete(git, "git_poll_interval_seconds")
+	git["git_poll_interval"] = interval
+
+	state["data_source_git"], err = json.Marshal(git)
+	return err
+}
+
+// secondsToDuration converts a JSON number of seconds to a JSON duration
+// string, keeping null values.
+func secondsToDuration(raw json.RawMessage) (json.RawMessage, error) {
+	var seconds *int64
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &seconds); err != nil {
+			return nil, err
+		}
+	}
+
+	if seconds == nil {
+		return json.RawMessage("null"), nil
+	}
+
+	return json.Marshal((time.Duration(*seconds) * time.Second).String())
+}
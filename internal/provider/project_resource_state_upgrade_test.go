@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProjectResourceUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := NewProjectResource().(*projectResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	req := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{
+			JSON: []byte(`{
+				"id": "example",
+				"project_name": "example",
+				"project_variables": [
+					{"name": "job", "value": "dev-advocate", "sensitive": false},
+					{"name": "name", "value": null, "sensitive_value": "devopsrob", "sensitive": null}
+				],
+				"app_status_poll_seconds": 12,
+				"data_source_git": {"git_url": "https://github.com/hashicorp/waypoint-examples", "git_poll_interval_seconds": 15}
+			}`),
+		},
+	}
+	resp := &resource.UpgradeStateResponse{}
+	r.UpgradeState(ctx)[0].StateUpgrader(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	// The upgraded state must decode with the current schema
+	upgraded, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("upgraded state does not match current schema: %s", err)
+	}
+
+	var value string
+	path := tftypes.NewAttributePath().WithAttributeName("variables").WithElementKeyString("name").WithAttributeName("sensitive_value")
+	raw, _, err := tftypes.WalkAttributePath(upgraded, path)
+	if err != nil {
+		t.Fatalf("sensitive variable missing from upgraded state: %s", err)
+	}
+	if err := raw.(tftypes.Value).As(&value); err != nil || value != "devopsrob" {
+		t.Fatalf("expected sensitive_value devopsrob, got %q (%v)", value, err)
+	}
+
+	path = tftypes.NewAttributePath().WithAttributeName("variables").WithElementKeyString("job").WithAttributeName("value")
+	raw, _, err = tftypes.WalkAttributePath(upgraded, path)
+	if err != nil {
+		t.Fatalf("variable missing from upgraded state: %s", err)
+	}
+	if err := raw.(tftypes.Value).As(&value); err != nil || value != "dev-advocate" {
+		t.Fatalf("expected value dev-advocate, got %q (%v)", value, err)
+	}
+
+	path = tftypes.NewAttributePath().WithAttributeName("data_source_git").WithAttributeName("git_poll_interval")
+	raw, _, err = tftypes.WalkAttributePath(upgraded, path)
+	if err != nil {
+		t.Fatalf("git poll interval missing from upgraded state: %s", err)
+	}
+	if err := raw.(tftypes.Value).As(&value); err != nil || value != "15s" {
+		t.Fatalf("expected git_poll_interval 15s, got %q (%v)", value, err)
+	}
+}
+
+func TestProjectResourceUpgradeStateV0_nullGitPollInterval(t *testing.T) {
+	ctx := context.Background()
+	r := NewProjectResource().(*projectResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	req := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{
+			JSON: []byte(`{
+				"id": "example",
+				"project_name": "example",
+				"app_status_poll_seconds": 90,
+				"data_source_git": {"git_url": "https://github.com/hashicorp/waypoint-examples", "git_poll_interval_seconds": null}
+			}`),
+		},
+	}
+	resp := &resource.UpgradeStateResponse{}
+	r.UpgradeState(ctx)[0].StateUpgrader(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	upgraded, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("upgraded state does not match current schema: %s", err)
+	}
+
+	var value string
+	raw, _, err := tftypes.WalkAttributePath(upgraded, tftypes.NewAttributePath().WithAttributeName("app_status_poll_interval"))
+	if err != nil {
+		t.Fatalf("app status poll interval missing from upgraded state: %s", err)
+	}
+	if err := raw.(tftypes.Value).As(&value); err != nil || value != "1m30s" {
+		t.Fatalf("expected app_status_poll_interval 1m30s, got %q (%v)", value, err)
+	}
+
+	raw, _, err = tftypes.WalkAttributePath(upgraded, tftypes.NewAttributePath().WithAttributeName("data_source_git").WithAttributeName("git_poll_interval"))
+	if err != nil {
+		t.Fatalf("git poll interval missing from upgraded state: %s", err)
+	}
+	if !raw.(tftypes.Value).IsNull() {
+		t.Fatalf("expected git_poll_interval to stay null, got %s", raw)
+	}
+}
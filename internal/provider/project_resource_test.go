@@ -4,9 +4,11 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccProjectResource(t *testing.T) {
@@ -23,17 +25,18 @@ func TestAccProjectResource(t *testing.T) {
 					resource.TestCheckResourceAttr("waypoint_project.example", "data_source_git.git_path", "docker/go"),
 					resource.TestCheckResourceAttr("waypoint_project.example", "data_source_git.git_ref", "HEAD"),
 					resource.TestCheckResourceAttr("waypoint_project.example", "data_source_git.file_change_signal", "some-signal"),
-					resource.TestCheckResourceAttr("waypoint_project.example", "data_source_git.git_poll_interval_seconds", "15"),
-					resource.TestCheckResourceAttr("waypoint_project.example", "app_status_poll_seconds", "12"),
-					resource.TestCheckResourceAttr("waypoint_project.example", "project_variables.0.name", "name"),
-					resource.TestCheckResourceAttr("waypoint_project.example", "project_variables.0.value", "devopsrob"),
-					resource.TestCheckResourceAttr("waypoint_project.example", "project_variables.0.sensitive", "true"),
-					resource.TestCheckResourceAttr("waypoint_project.example", "project_variables.1.name", "job"),
-					resource.TestCheckResourceAttr("waypoint_project.example", "project_variables.1.value", "dev-advocate"),
-					resource.TestCheckResourceAttr("waypoint_project.example", "project_variables.1.sensitive", "false"),
-					resource.TestCheckResourceAttr("waypoint_project.example", "project_variables.2.name", "conference"),
-					resource.TestCheckResourceAttr("waypoint_project.example", "project_variables.2.value", "HashiConf EU 2022"),
-					resource.TestCheckResourceAttr("waypoint_project.example", "project_variables.2.sensitive", "false"),
+					resource.TestCheckResourceAttr("waypoint_project.example", "data_source_git.git_poll_interval", "15s"),
+					resource.TestCheckResourceAttr("waypoint_project.example", "data_source_git.recurse_submodules", "1"),
+					resource.TestCheckResourceAttr("waypoint_project.example", "app_status_poll_interval", "12s"),
+					resource.TestCheckResourceAttr("waypoint_project.example", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("waypoint_project.example", "destroy_behavior", "destroy"),
+					resource.TestCheckResourceAttr("waypoint_project.example", "variables.%", "3"),
+					resource.TestCheckResourceAttr("waypoint_project.example", "variables.name.sensitive_value", "devopsrob"),
+					resource.TestCheckNoResourceAttr("waypoint_project.example", "variables.name.value"),
+					resource.TestCheckResourceAttr("waypoint_project.example", "variables.job.value", "dev-advocate"),
+					resource.TestCheckResourceAttr("waypoint_project.example", "variables.job.sensitive", "false"),
+					resource.TestCheckResourceAttr("waypoint_project.example", "variables.conference.value", "HashiConf EU 2022"),
+					resource.TestCheckResourceAttr("waypoint_project.example", "variables.conference.sensitive", "false"),
 					resource.TestCheckResourceAttr("waypoint_project.example", "git_auth_basic.%", "2"),
 					resource.TestCheckResourceAttr("waypoint_project.example", "git_auth_basic.username", "catsby"),
 					resource.TestCheckResourceAttr("waypoint_project.example", "git_auth_basic.password", "test"),
@@ -41,15 +44,15 @@ func TestAccProjectResource(t *testing.T) {
 					// Example1 from examples directory
 					resource.TestCheckResourceAttr("waypoint_project.example1", "project_name", "example1"),
 					resource.TestCheckResourceAttr("waypoint_project.example1", "remote_runners_enabled", "true"),
-					resource.TestCheckResourceAttr("waypoint_project.example1", "data_source_git.git_url", "https://github.com/hashicorp/waypoint-examples"),
+					resource.TestCheckResourceAttr("waypoint_project.example1", "data_source_git.git_url", "git@github.com:hashicorp/waypoint-examples.git"),
 					resource.TestCheckResourceAttr("waypoint_project.example1", "data_source_git.git_path", "docker/go"),
 					resource.TestCheckResourceAttr("waypoint_project.example1", "data_source_git.git_ref", "HEAD"),
 					resource.TestCheckResourceAttr("waypoint_project.example1", "data_source_git.file_change_signal", "some-signal"),
-					resource.TestCheckResourceAttr("waypoint_project.example1", "data_source_git.git_poll_interval_seconds", "15"),
-					resource.TestCheckResourceAttr("waypoint_project.example1", "app_status_poll_seconds", "12"),
-					resource.TestCheckResourceAttr("waypoint_project.example1", "project_variables.0.name", "devopsrob"),
-					resource.TestCheckResourceAttr("waypoint_project.example1", "project_variables.0.value", "dev-advocate"),
-					resource.TestCheckResourceAttr("waypoint_project.example1", "project_variables.0.sensitive", "false"),
+					resource.TestCheckResourceAttr("waypoint_project.example1", "data_source_git.git_poll_interval", "15s"),
+					resource.TestCheckResourceAttr("waypoint_project.example1", "data_source_git.git_polling_enabled", "false"),
+					resource.TestCheckResourceAttr("waypoint_project.example1", "app_status_poll_interval", "12s"),
+					resource.TestCheckResourceAttr("waypoint_project.example1", "variables.devopsrob.value", "dev-advocate"),
+					resource.TestCheckResourceAttr("waypoint_project.example1", "variables.devopsrob.sensitive", "false"),
 					resource.TestCheckResourceAttr("waypoint_project.example1", "git_auth_ssh.%", "3"),
 					resource.TestCheckResourceAttr("waypoint_project.example1", "git_auth_ssh.git_user", "cassie"),
 					resource.TestCheckResourceAttr("waypoint_project.example1", "git_auth_ssh.passphrase", "test"),
@@ -60,3 +63,100 @@ func TestAccProjectResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccProjectResource_rename(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceRenameConfig("example-rename"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("waypoint_project.rename", "id", "example-rename"),
+					resource.TestCheckNoResourceAttr("waypoint_project.rename", "app_status_poll_interval"),
+				),
+			},
+			{
+				Config: testAccProjectResourceRenameConfig("example-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("waypoint_project.rename", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("waypoint_project.rename", "id", "example-renamed"),
+					resource.TestCheckNoResourceAttr("waypoint_project.rename", "app_status_poll_interval"),
+				),
+			},
+		},
+	})
+}
+
+// testAccProjectResourceRenameConfig leaves out every optional attribute, so
+// computed ones such as app_status_poll_interval must be known after apply.
+func testAccProjectResourceRenameConfig(name string) string {
+	return fmt.Sprintf(`
+resource "waypoint_project" "rename" {
+  project_name = %q
+
+  data_source_git = {
+    git_url  = "https://github.com/hashicorp/waypoint-examples"
+    git_path = "docker/go"
+    git_ref  = "HEAD"
+  }
+}
+`, name)
+}
+
+func TestAccProjectResource_appStatusPollInterval(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceAppStatusPollConfig(""),
+				Check:  resource.TestCheckNoResourceAttr("waypoint_project.app_status_poll", "app_status_poll_interval"),
+			},
+			{
+				Config: testAccProjectResourceAppStatusPollConfig(`app_status_poll_interval = "30s"`),
+				Check:  resource.TestCheckResourceAttr("waypoint_project.app_status_poll", "app_status_poll_interval", "30s"),
+			},
+			{
+				Config: testAccProjectResourceAppStatusPollConfig(""),
+				Check:  resource.TestCheckNoResourceAttr("waypoint_project.app_status_poll", "app_status_poll_interval"),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_appStatusPollingDisabled(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceAppStatusPollConfig(`app_status_polling_enabled = false`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("waypoint_project.app_status_poll", "app_status_polling_enabled", "false"),
+					resource.TestCheckNoResourceAttr("waypoint_project.app_status_poll", "app_status_poll_interval"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectResourceAppStatusPollConfig(poll string) string {
+	return fmt.Sprintf(`
+resource "waypoint_project" "app_status_poll" {
+  project_name = "example-app-status-poll"
+
+  data_source_git = {
+    git_url  = "https://github.com/hashicorp/waypoint-examples"
+    git_path = "docker/go"
+    git_ref  = "HEAD"
+  }
+
+  %s
+}
+`, poll)
+}
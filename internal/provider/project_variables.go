@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// privateKeyVariableHashes is the private state key holding the SHA256
+// hashes of sensitive project variable values, keyed by variable name.
+const privateKeyVariableHashes = "sensitive_variable_hashes"
+
+// privateState is the subset of the framework private state API shared by
+// the request and response types of each resource operation.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// variableHash returns the hex encoded SHA256 hash of a variable value
+func variableHash(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// isSensitive reports whether a variable should be stored as sensitive on
+// the server. Setting sensitive_value implies the variable is sensitive.
+func (v *variablesModel) isSensitive() bool {
+	return v.Sensitive.ValueBool() || !v.SensitiveValue.IsNull()
+}
+
+// value returns the variable value from whichever attribute is set
+func (v *variablesModel) value() string {
+	if !v.SensitiveValue.IsNull() {
+		return v.SensitiveValue.ValueString()
+	}
+
+	return v.Value.ValueString()
+}
+
+// expandProjectVariables converts the variables model to the list of
+// variables sent to the Waypoint server, ordered by name.
+func expandProjectVariables(variables map[string]*variablesModel) []*gen.Variable {
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var variableList []*gen.Variable
+	for _, name := range names {
+		variable := variables[name]
+		projectVariable := waypointClient.SetVariable()
+		projectVariable.Name = name
+		projectVariable.Value = &gen.Variable_Str{Str: variable.value()}
+		projectVariable.Sensitive = variable.isSensitive()
+		variableList = append(variableList, &projectVariable)
+	}
+
+	return variableList
+}
+
+// flattenProjectVariables converts the variables returned by the Waypoint
+// server to the variables model keyed by name. When prior is given, each
+// variable keeps the attribute layout it was configured with, and sensitive
+// values the server returns hashed are matched against hashes to avoid
+// reporting false drift.
+func flattenProjectVariables(variables []*gen.Variable, prior map[string]*variablesModel, hashes map[string]string) map[string]*variablesModel {
+	if len(variables) == 0 {
+		return nil
+	}
+
+	projectVariables := make(map[string]*variablesModel, len(variables))
+	for _, v := range variables {
+		pvar := variablesModel{
+			Value:          types.StringNull(),
+			SensitiveValue: types.StringNull(),
+			Sensitive:      types.BoolValue(v.Sensitive),
+		}
+
+		// we only support string values(?)
+		str := v.GetStr()
+
+		p, ok := prior[v.Name]
+		if v.Sensitive && ok {
+			if h := hashes[v.Name]; h != "" && (str == h || variableHash(str) == h) {
+				str = p.value()
+			}
+		}
+
+		switch {
+		case ok && !p.Value.IsNull():
+			pvar.Value = types.StringValue(str)
+		case ok && !p.SensitiveValue.IsNull(), !ok && v.Sensitive:
+			pvar.SensitiveValue = types.StringValue(str)
+		default:
+			pvar.Value = types.StringValue(str)
+		}
+
+		// Leave sensitive unset when it was omitted and the server agrees
+		// with what sensitive_value implies.
+		if ok && p.Sensitive.IsNull() && v.Sensitive == p.isSensitive() {
+			pvar.Sensitive = types.BoolNull()
+		}
+
+		projectVariables[v.Name] = &pvar
+	}
+
+	return projectVariables
+}
+
+// getVariableHashes loads the sensitive variable hashes from private state
+func getVariableHashes(ctx context.Context, private privateState) (map[string]string, diag.Diagnostics) {
+	hashes := make(map[string]string)
+
+	raw, diags := private.GetKey(ctx, privateKeyVariableHashes)
+	if diags.HasError() || raw == nil {
+		return hashes, diags
+	}
+
+	if err := json.Unmarshal(raw, &hashes); err != nil {
+		diags.AddError(
+			"Error Reading Private State",
+			"Could not decode sensitive project variable hashes: "+err.Error(),
+		)
+	}
+
+	return hashes, diags
+}
+
+// setVariableHashes stores the hashes of all sensitive variable values in
+// private state, so the values themselves never need to be compared in
+// clear text.
+func setVariableHashes(ctx context.Context, private privateState, variables map[string]*variablesModel) diag.Diagnostics {
+	hashes := make(map[string]string)
+	for name, v := range variables {
+		if v.isSensitive() {
+			hashes[name] = variableHash(v.value())
+		}
+	}
+
+	raw, err := json.Marshal(hashes)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error Writing Private State",
+			"Could not encode sensitive project variable hashes: "+err.Error(),
+		)
+		return diags
+	}
+
+	return private.SetKey(ctx, privateKeyVariableHashes, raw)
+}
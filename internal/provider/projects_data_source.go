@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &projectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectsDataSource{}
+)
+
+// NewProjectsDataSource is a helper function to simplify the provider implementation.
+func NewProjectsDataSource() datasource.DataSource {
+	return &projectsDataSource{}
+}
+
+// projectsDataSource is the data source implementation.
+type projectsDataSource struct {
+	client waypointClient.Waypoint
+}
+
+// projectsDataSourceModel maps the schema data.
+type projectsDataSourceModel struct {
+	NamePrefix           types.String `tfsdk:"name_prefix"`
+	NameRegex            types.String `tfsdk:"name_regex"`
+	RemoteRunnersEnabled types.Bool   `tfsdk:"remote_runners_enabled"`
+	GitURL               types.String `tfsdk:"git_url"`
+
+	Projects []projectsItemModel `tfsdk:"projects"`
+}
+
+// projectsItemModel maps a single project in the list.
+type projectsItemModel struct {
+	Name                 types.String        `tfsdk:"project_name"`
+	RemoteRunnersEnabled types.Bool          `tfsdk:"remote_runners_enabled"`
+	Applications         types.List          `tfsdk:"applications"`
+	DataSourceGit        *dataSourceGitModel `tfsdk:"data_source_git"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *projectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(waypointClient.Waypoint)
+}
+
+// Metadata returns the data source type name.
+func (d *projectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+// Schema defines the schema for the data source
+func (d *projectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Waypoint projects, optionally filtered. All filters must match for a project to be included",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include projects whose name starts with this prefix",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include projects whose name matches this regular expression",
+			},
+			"remote_runners_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only include projects with remote runners enabled, or disabled when false",
+			},
+			"git_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include projects whose git data source uses this URL",
+			},
+			"projects": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching projects, ordered by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Waypoint project",
+						},
+						"remote_runners_enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether remote runners are enabled for the project",
+						},
+						"applications": schema.ListAttribute{
+							Computed:    true,
+							Description: "List of applications for this project",
+							ElementType: types.StringType,
+						},
+						"data_source_git": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "Configuration of Git repository where waypoint.hcl file is stored",
+							Attributes:  dataSourceGitAttributes(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state projectsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				"Could not compile name_regex: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	refs, err := listProjects(ctx, d.client.GRPCClient())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Projects",
+			"Could not list projects: "+err.Error(),
+		)
+		return
+	}
+
+	// Names are filtered first, so only matching projects are fetched
+	var names []string
+	for _, ref := range refs {
+		name := ref.GetProject()
+		if !strings.HasPrefix(name, state.NamePrefix.ValueString()) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	state.Projects = []projectsItemModel{}
+	for _, name := range names {
+		project, err := d.client.GetProject(ctx, name)
+		if err != nil {
+			// The project was destroyed after it was listed
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Reading Project",
+				"Could not read Project with name "+name+": "+err.Error(),
+			)
+			return
+		}
+
+		if !state.RemoteRunnersEnabled.IsNull() && project.RemoteEnabled != state.RemoteRunnersEnabled.ValueBool() {
+			continue
+		}
+
+		git, diags := flattenDataSourceGit(ctx, project)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !state.GitURL.IsNull() && (git == nil || git.Url.ValueString() != state.GitURL.ValueString()) {
+			continue
+		}
+
+		apps, diags := flattenApplications(ctx, project.Applications)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Projects = append(state.Projects, projectsItemModel{
+			Name:                 types.StringValue(project.Name),
+			RemoteRunnersEnabled: types.BoolValue(project.RemoteEnabled),
+			Applications:         apps,
+			DataSourceGit:        git,
+		})
+	}
+	tflog.Debug(ctx, "Listed projects", map[string]interface{}{"count": len(state.Projects)})
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listProjects returns references to all projects on the server, following
+// pagination until the last page.
+func listProjects(ctx context.Context, client gen.WaypointClient) ([]*gen.Ref_Project, error) {
+	var projects []*gen.Ref_Project
+
+	pagination := &gen.PaginationRequest{}
+	for {
+		resp, err := client.ListProjects(ctx, &gen.ListProjectsRequest{Pagination: pagination})
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, resp.Projects...)
+
+		next := resp.GetPagination().GetNextPageToken()
+		if next == "" {
+			return projects, nil
+		}
+		pagination = &gen.PaginationRequest{NextPageToken: next}
+	}
+}
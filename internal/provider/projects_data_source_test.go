@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"google.golang.org/grpc"
+)
+
+// testPagedProjectsClient serves ListProjects one page at a time, using the
+// page index as the page token.
+type testPagedProjectsClient struct {
+	gen.WaypointClient
+	pages [][]string
+}
+
+func (c *testPagedProjectsClient) ListProjects(_ context.Context, req *gen.ListProjectsRequest, _ ...grpc.CallOption) (*gen.ListProjectsResponse, error) {
+	page := 0
+	if token := req.GetPagination().GetNextPageToken(); token != "" {
+		page = int(token[0] - '0')
+	}
+
+	resp := &gen.ListProjectsResponse{Pagination: &gen.PaginationResponse{}}
+	for _, name := range c.pages[page] {
+		resp.Projects = append(resp.Projects, &gen.Ref_Project{Project: name})
+	}
+	if page+1 < len(c.pages) {
+		resp.Pagination.NextPageToken = string(rune('0' + page + 1))
+	}
+
+	return resp, nil
+}
+
+func TestListProjects(t *testing.T) {
+	client := &testPagedProjectsClient{pages: [][]string{{"alpha", "beta"}, {"gamma"}, {"delta"}}}
+
+	refs, err := listProjects(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var names []string
+	for _, ref := range refs {
+		names = append(names, ref.Project)
+	}
+	if want := []string{"alpha", "beta", "gamma", "delta"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("expected %v, got %v", want, names)
+	}
+}
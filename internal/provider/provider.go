@@ -6,14 +6,17 @@ package provider
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-waypoint/internal/timetypes"
 
 	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
 )
@@ -30,8 +33,18 @@ type waypointProvider struct {
 }
 
 type waypointProviderModel struct {
-	Host  types.String `tfsdk:"host"`
-	Token types.String `tfsdk:"token"`
+	Host               types.String         `tfsdk:"host"`
+	Token              types.String         `tfsdk:"token"`
+	TokenExpiryWarning timetypes.GoDuration `tfsdk:"token_expiry_warning"`
+}
+
+// providerClient is the client shared with data sources and resources. It
+// keeps the host the client connects to and the token it authenticates
+// with, so the token can be inspected and server URLs can be built.
+type providerClient struct {
+	waypointClient.Waypoint
+	host  string
+	token string
 }
 
 // New creates a new WaypointProvider
@@ -59,6 +72,14 @@ func (p *waypointProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 			"token": schema.StringAttribute{
 				Optional: true,
 			},
+			"token_expiry_warning": schema.StringAttribute{
+				CustomType:  timetypes.GoDurationType{},
+				Optional:    true,
+				Description: "Warn when the token expires within this duration, such as `168h`. The token is inspected when the provider is configured",
+				Validators: []validator.String{
+					timetypes.GoDurationAtLeast(time.Second),
+				},
+			},
 		},
 	}
 }
@@ -165,10 +186,30 @@ func (p *waypointProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	if !config.TokenExpiryWarning.IsNull() {
+		window, diags := config.TokenExpiryWarning.ValueGoDuration()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The token may still work, so failing to inspect it is only a warning
+		summary, err := describeToken(ctx, wc.GRPCClient(), token)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to Inspect Waypoint Token",
+				"Could not decode the Waypoint token to check its expiry: "+err.Error(),
+			)
+		} else {
+			resp.Diagnostics.Append(checkTokenExpiry(summary, window)...)
+		}
+	}
+
 	// Make the waypoint client available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = wc
-	resp.ResourceData = wc
+	client := &providerClient{Waypoint: wc, host: host, token: token}
+	resp.DataSourceData = client
+	resp.ResourceData = client
 
 	tflog.Info(ctx, "Configured waypoint client", map[string]any{"success": true})
 }
@@ -178,8 +219,20 @@ func (p *waypointProvider) DataSources(_ context.Context) []func() datasource.Da
 	return []func() datasource.DataSource{
 		NewAuthMethodDataSource,
 		NewProjectDataSource,
+		NewProjectsDataSource,
 		NewRunnerProfileDataSource,
+		NewRunnersDataSource,
 		NewAppDataSource,
+		NewApplicationsDataSource,
+		NewLatestBuildDataSource,
+		NewLatestDeploymentDataSource,
+		NewLatestReleaseDataSource,
+		NewPipelineDataSource,
+		NewPipelinesDataSource,
+		NewStatusReportDataSource,
+		NewTokenInfoDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
 	}
 }
 
@@ -190,5 +243,10 @@ func (p *waypointProvider) Resources(_ context.Context) []func() resource.Resour
 		NewConfigSourceResource,
 		NewProjectResource,
 		NewRunnerProfileResource,
+		NewRunnerAdoptionResource,
+		NewRunnerTokenResource,
+		NewTokenResource,
+		NewTriggerResource,
+		NewUserProfileResource,
 	}
 }
@@ -4,10 +4,14 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 var (
@@ -27,3 +31,26 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+// testResourceSchema returns the schema of a resource.
+func testResourceSchema(r resource.Resource) schema.Schema {
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+	return resp.Schema
+}
+
+// testResourceValue returns a value of the resource schema s with the given
+// attributes set, and every other attribute null.
+func testResourceValue(s schema.Schema, attributes map[string]tftypes.Value) tftypes.Value {
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+		if v, ok := attributes[name]; ok {
+			values[name] = v
+		}
+	}
+
+	return tftypes.NewValue(objectType, values)
+}
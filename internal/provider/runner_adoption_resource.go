@@ -0,0 +1,389 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-waypoint/internal/defaults"
+	"github.com/hashicorp/terraform-provider-waypoint/internal/timetypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// runnerPollInterval is how often the runner list is checked while waiting
+// for a runner to register.
+const runnerPollInterval = 5 * time.Second
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &runnerAdoptionResource{}
+	_ resource.ResourceWithConfigure = &runnerAdoptionResource{}
+)
+
+// NewRunnerAdoptionResource is a helper function to simplify the provider implementation.
+func NewRunnerAdoptionResource() resource.Resource {
+	return &runnerAdoptionResource{}
+}
+
+// runnerAdoptionResource is the resource implementation.
+type runnerAdoptionResource struct {
+	client waypointClient.Waypoint
+}
+
+// runnerAdoptionResourceModel maps the resource schema data.
+type runnerAdoptionResourceModel struct {
+	ID            types.String         `tfsdk:"id"`
+	RunnerID      types.String         `tfsdk:"runner_id"`
+	RunnerLabels  types.Map            `tfsdk:"runner_labels"`
+	Adopt         types.Bool           `tfsdk:"adopt"`
+	WaitTimeout   timetypes.GoDuration `tfsdk:"wait_timeout"`
+	AdoptionState types.String         `tfsdk:"adoption_state"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *runnerAdoptionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(waypointClient.Waypoint)
+}
+
+// Metadata returns the resource type name.
+func (r *runnerAdoptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_runner_adoption"
+}
+
+// Schema defines the schema for the resource.
+func (r *runnerAdoptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Adopts or rejects a static runner that registered with the Waypoint server, so it can be given jobs. The runner is forgotten when the resource is destroyed, which returns it to the pending state",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the adopted runner",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"runner_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the runner to adopt. Set to the ID of the matched runner when runner_labels is used",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// Only a configured ID change replaces the
+							// adoption, not the ID matched by labels.
+							resp.RequiresReplace = !req.ConfigValue.IsNull()
+						},
+						"Changing the runner ID adopts a different runner",
+						"Changing the runner ID adopts a different runner",
+					),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("runner_labels")),
+				},
+			},
+			"runner_labels": schema.MapAttribute{
+				Optional:    true,
+				Description: "Adopt the pending static runner that has all of these labels. Exactly one pending runner must match, runners that were already adopted or rejected are ignored",
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"adopt": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Adopt the runner when true, or reject it when false so it exits on startup. Defaults to true",
+				PlanModifiers: []planmodifier.Bool{
+					defaults.BoolDefaultValue(types.BoolValue(true)),
+				},
+			},
+			"wait_timeout": schema.StringAttribute{
+				CustomType:  timetypes.GoDurationType{},
+				Optional:    true,
+				Description: "How long to wait for the runner to register, as a duration such as `30s` or `5m`. Useful when the runner is provisioned in the same apply. The runner must already be registered when unset",
+				Validators: []validator.String{
+					timetypes.GoDurationAtLeast(time.Second),
+				},
+			},
+			"adoption_state": schema.StringAttribute{
+				Computed:    true,
+				Description: "Adoption state of the runner, one of `ADOPTED` or `REJECTED`",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *runnerAdoptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Adopting Runner")
+	// Retrieve values from plan
+	var plan runnerAdoptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runner, diags := r.waitForRunner(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(runner.Id)
+	plan.RunnerID = types.StringValue(runner.Id)
+
+	resp.Diagnostics.Append(r.adopt(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *runnerAdoptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state runnerAdoptionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runnerID := state.RunnerID.ValueString()
+	ctx = tflog.SetField(ctx, "waypoint_runner", runnerID)
+
+	runner, err := r.client.GRPCClient().GetRunner(ctx, &gen.GetRunnerRequest{RunnerId: runnerID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			tflog.Info(ctx, "Runner not found, removing from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Runner",
+			"Could not read Runner with ID "+runnerID+": "+err.Error(),
+		)
+		return
+	}
+
+	// A runner that was forgotten outside of Terraform is pending again and
+	// needs to be adopted again.
+	if runner.AdoptionState == gen.Runner_PENDING {
+		tflog.Info(ctx, "Runner is pending adoption, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Adopt = types.BoolValue(runner.AdoptionState != gen.Runner_REJECTED)
+	state.AdoptionState = types.StringValue(runner.AdoptionState.String())
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *runnerAdoptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Runner Adoption")
+	// Retrieve values from plan
+	var plan runnerAdoptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.adopt(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *runnerAdoptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state runnerAdoptionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runnerID := state.RunnerID.ValueString()
+	ctx = tflog.SetField(ctx, "waypoint_runner", runnerID)
+
+	_, err := r.client.GRPCClient().ForgetRunner(ctx, &gen.ForgetRunnerRequest{RunnerId: runnerID})
+	if err != nil && status.Code(err) != codes.NotFound {
+		resp.Diagnostics.AddError(
+			"Error Forgetting Waypoint Runner",
+			"Could not forget runner, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// adopt adopts or rejects the runner in plan and records its new state.
+func (r *runnerAdoptionResource) adopt(ctx context.Context, plan *runnerAdoptionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	runnerID := plan.RunnerID.ValueString()
+	ctx = tflog.SetField(ctx, "waypoint_runner", runnerID)
+
+	_, err := r.client.GRPCClient().AdoptRunner(ctx, &gen.AdoptRunnerRequest{
+		RunnerId: runnerID,
+		Adopt:    plan.Adopt.ValueBool(),
+	})
+	if err != nil {
+		diags.AddError(
+			"Error Adopting Runner",
+			"Could not adopt runner "+runnerID+", unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if plan.Adopt.ValueBool() {
+		plan.AdoptionState = types.StringValue(gen.Runner_ADOPTED.String())
+	} else {
+		plan.AdoptionState = types.StringValue(gen.Runner_REJECTED.String())
+	}
+
+	return diags
+}
+
+// waitForRunner finds the runner selected by plan, polling until it has
+// registered or wait_timeout has passed.
+func (r *runnerAdoptionResource) waitForRunner(ctx context.Context, plan runnerAdoptionResourceModel) (*gen.Runner, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	labels := make(map[string]string)
+	diags.Append(plan.RunnerLabels.ElementsAs(ctx, &labels, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var timeout time.Duration
+	if !plan.WaitTimeout.IsNull() {
+		timeout, diags = plan.WaitTimeout.ValueGoDuration()
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	ticker := time.NewTicker(runnerPollInterval)
+	defer ticker.Stop()
+
+	for {
+		runner, err := findRunner(ctx, r.client.GRPCClient(), plan.RunnerID.ValueString(), labels)
+		if err != nil {
+			diags.AddError(
+				"Error Finding Runner",
+				"Could not find the runner to adopt: "+err.Error(),
+			)
+			return nil, diags
+		}
+		if runner != nil {
+			return runner, diags
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError(
+				"Error Finding Runner",
+				"Stopped waiting for the runner to register: "+ctx.Err().Error(),
+			)
+			return nil, diags
+		case <-deadline.C:
+			diags.AddError(
+				"Runner Not Found",
+				"No matching runner has registered with the Waypoint server. "+
+					"Check the runner is running, or set wait_timeout to wait for it to register.",
+			)
+			return nil, diags
+		case <-ticker.C:
+			tflog.Debug(ctx, "Waiting for runner to register")
+		}
+	}
+}
+
+// findRunner returns the runner with the given ID, or else the only pending
+// static runner that has all of the given labels. Runners that were already
+// adopted or rejected are not matched by labels, so a new runner can be
+// adopted alongside them. It returns nil when no runner matches yet.
+func findRunner(ctx context.Context, client gen.WaypointClient, id string, labels map[string]string) (*gen.Runner, error) {
+	if id != "" {
+		runner, err := client.GetRunner(ctx, &gen.GetRunnerRequest{RunnerId: id})
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return runner, err
+	}
+
+	resp, err := client.ListRunners(ctx, &gen.ListRunnersRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []*gen.Runner
+	for _, runner := range filterRunners(resp.Runners, labels) {
+		if runnerKind(runner) == runnerKindStatic && runner.AdoptionState == gen.Runner_PENDING {
+			matching = append(matching, runner)
+		}
+	}
+
+	switch len(matching) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matching[0], nil
+	default:
+		ids := make([]string, 0, len(matching))
+		for _, runner := range matching {
+			ids = append(ids, runner.Id)
+		}
+		sort.Strings(ids)
+		return nil, fmt.Errorf("%d pending static runners match the labels (%s), set runner_id to choose one", len(ids), strings.Join(ids, ", "))
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testRunnersClient serves a fixed list of runners.
+type testRunnersClient struct {
+	gen.WaypointClient
+	runners []*gen.Runner
+}
+
+func (c *testRunnersClient) ListRunners(context.Context, *gen.ListRunnersRequest, ...grpc.CallOption) (*gen.ListRunnersResponse, error) {
+	return &gen.ListRunnersResponse{Runners: c.runners}, nil
+}
+
+func (c *testRunnersClient) GetRunner(_ context.Context, req *gen.GetRunnerRequest, _ ...grpc.CallOption) (*gen.Runner, error) {
+	for _, runner := range c.runners {
+		if runner.Id == req.RunnerId {
+			return runner, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "runner not found")
+}
+
+func TestFindRunner(t *testing.T) {
+	static := func(id string, labels map[string]string) *gen.Runner {
+		return &gen.Runner{Id: id, Labels: labels, Kind: &gen.Runner_Remote_{Remote: &gen.Runner_Remote{}}}
+	}
+	client := &testRunnersClient{runners: []*gen.Runner{
+		static("a", map[string]string{"env": "prod"}),
+		static("b", map[string]string{"env": "dev"}),
+		static("c", map[string]string{"env": "dev"}),
+		{Id: "odr", Labels: map[string]string{"env": "prod"}, Kind: &gen.Runner_Odr{Odr: &gen.Runner_ODR{}}},
+	}}
+	ctx := context.Background()
+
+	if runner, err := findRunner(ctx, client, "b", nil); err != nil || runner.Id != "b" {
+		t.Errorf("expected runner b by ID, got %v (%v)", runner, err)
+	}
+	if runner, err := findRunner(ctx, client, "missing", nil); err != nil || runner != nil {
+		t.Errorf("expected no runner for a missing ID, got %v (%v)", runner, err)
+	}
+
+	// The on-demand runner also has the labels but is never adopted
+	if runner, err := findRunner(ctx, client, "", map[string]string{"env": "prod"}); err != nil || runner.Id != "a" {
+		t.Errorf("expected runner a by labels, got %v (%v)", runner, err)
+	}
+	if runner, err := findRunner(ctx, client, "", map[string]string{"env": "staging"}); err != nil || runner != nil {
+		t.Errorf("expected no runner for unmatched labels, got %v (%v)", runner, err)
+	}
+	if _, err := findRunner(ctx, client, "", map[string]string{"env": "dev"}); err == nil || !strings.Contains(err.Error(), "b, c") {
+		t.Errorf("expected an error listing the ambiguous runners, got %v", err)
+	}
+}
+
+func TestFindRunner_adopted(t *testing.T) {
+	labels := map[string]string{"env": "prod"}
+	remote := &gen.Runner_Remote_{Remote: &gen.Runner_Remote{}}
+	client := &testRunnersClient{runners: []*gen.Runner{
+		{Id: "old", Labels: labels, Kind: remote, AdoptionState: gen.Runner_ADOPTED},
+		{Id: "new", Labels: labels, Kind: remote, AdoptionState: gen.Runner_PENDING},
+	}}
+	ctx := context.Background()
+
+	if runner, err := findRunner(ctx, client, "", labels); err != nil || runner.Id != "new" {
+		t.Errorf("expected the pending runner by labels, got %v (%v)", runner, err)
+	}
+
+	// An ID still finds a runner that was already adopted
+	if runner, err := findRunner(ctx, client, "old", nil); err != nil || runner.Id != "old" {
+		t.Errorf("expected the adopted runner by ID, got %v (%v)", runner, err)
+	}
+
+	client.runners = client.runners[:1]
+	if runner, err := findRunner(ctx, client, "", labels); err != nil || runner != nil {
+		t.Errorf("expected the adopted runner not to match by labels, got %v (%v)", runner, err)
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-waypoint/internal/timetypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &runnerTokenResource{}
+	_ resource.ResourceWithConfigure = &runnerTokenResource{}
+)
+
+// NewRunnerTokenResource is a helper function to simplify the provider implementation.
+func NewRunnerTokenResource() resource.Resource {
+	return &runnerTokenResource{}
+}
+
+// runnerTokenResource is the resource implementation.
+type runnerTokenResource struct {
+	client waypointClient.Waypoint
+}
+
+// runnerTokenResourceModel maps the resource schema data.
+type runnerTokenResourceModel struct {
+	ID        types.String         `tfsdk:"id"`
+	RunnerID  types.String         `tfsdk:"runner_id"`
+	Labels    types.Map            `tfsdk:"labels"`
+	TTL       timetypes.GoDuration `tfsdk:"ttl"`
+	Token     types.String         `tfsdk:"token"`
+	IssuedAt  types.String         `tfsdk:"issued_at"`
+	ExpiresAt types.String         `tfsdk:"expires_at"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *runnerTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(waypointClient.Waypoint)
+}
+
+// Metadata returns the resource type name.
+func (r *runnerTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_runner_token"
+}
+
+// Schema defines the schema for the resource.
+func (r *runnerTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a token a static runner can use to register with the Waypoint server. Runners that present the token are preadopted. The Waypoint API cannot revoke tokens, so destroying the resource only removes the token from Terraform state",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Accessor ID of the token, which identifies it without revealing it",
+			},
+			"runner_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only allow the runner with this ID to use the token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Optional:    true,
+				Description: "Only allow runners with exactly these labels to use the token",
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.StringAttribute{
+				CustomType:  timetypes.GoDurationType{},
+				Optional:    true,
+				Description: "How long the token is valid for, as a duration such as `1h` or `720h`. The token never expires when unset",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					timetypes.GoDurationAtLeast(time.Second),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The runner token",
+			},
+			"issued_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the token was issued, in RFC 3339 format",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the token expires, in RFC 3339 format. Null when the token never expires",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *runnerTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Generating Runner Token")
+	// Retrieve values from plan
+	var plan runnerTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	labels := make(map[string]string)
+	diags = plan.Labels.ElementsAs(ctx, &labels, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenReq := &gen.GenerateRunnerTokenRequest{
+		Id:     plan.RunnerID.ValueString(),
+		Labels: labels,
+	}
+	if !plan.TTL.IsNull() {
+		ttl, diags := plan.TTL.ValueGoDuration()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tokenReq.Duration = ttl.String()
+	}
+
+	token, err := r.client.GRPCClient().GenerateRunnerToken(ctx, tokenReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Generating Runner Token",
+			"Could not generate runner token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	summary, err := describeToken(ctx, r.client.GRPCClient(), token.Token)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Decoding Runner Token",
+			"Could not decode the generated runner token: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = summary.AccessorID
+	plan.Token = types.StringValue(token.Token)
+	plan.IssuedAt = summary.IssuedAt
+	plan.ExpiresAt = summary.ExpiresAt
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data. Tokens are not
+// stored by the server, so there is nothing to refresh.
+func (r *runnerTokenResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+// Update updates the resource and sets the updated Terraform state on
+// success. Every configurable attribute forces a new token, so this is never
+// called with changes.
+func (r *runnerTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan runnerTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *runnerTokenResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Info(ctx, "Runner tokens cannot be revoked, removing from state only")
+}
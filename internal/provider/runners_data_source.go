@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sort"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Runner kinds
+const (
+	runnerKindStatic   = "static"
+	runnerKindOnDemand = "on-demand"
+	runnerKindLocal    = "local"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &runnersDataSource{}
+	_ datasource.DataSourceWithConfigure = &runnersDataSource{}
+)
+
+// NewRunnersDataSource is a helper function to simplify the provider implementation.
+func NewRunnersDataSource() datasource.DataSource {
+	return &runnersDataSource{}
+}
+
+// runnersDataSource is the data source implementation.
+type runnersDataSource struct {
+	client waypointClient.Waypoint
+}
+
+// runnersDataSourceModel maps the schema data.
+type runnersDataSourceModel struct {
+	Labels map[string]string `tfsdk:"labels"`
+
+	Runners []runnersItemModel `tfsdk:"runners"`
+}
+
+// runnersItemModel maps a single runner in the list.
+type runnersItemModel struct {
+	ID            types.String `tfsdk:"id"`
+	Kind          types.String `tfsdk:"kind"`
+	Labels        types.Map    `tfsdk:"labels"`
+	AdoptionState types.String `tfsdk:"adoption_state"`
+	Online        types.Bool   `tfsdk:"online"`
+	ByIDOnly      types.Bool   `tfsdk:"by_id_only"`
+	FirstSeen     types.String `tfsdk:"first_seen"`
+	LastSeen      types.String `tfsdk:"last_seen"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *runnersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(waypointClient.Waypoint)
+}
+
+// Metadata returns the data source type name.
+func (d *runnersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_runners"
+}
+
+// Schema defines the schema for the data source
+func (d *runnersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the runners registered with the Waypoint server",
+		Attributes: map[string]schema.Attribute{
+			"labels": schema.MapAttribute{
+				Optional:    true,
+				Description: "Only include runners that have all of these labels",
+				ElementType: types.StringType,
+			},
+			"runners": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching runners, ordered by ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the runner",
+						},
+						"kind": schema.StringAttribute{
+							Computed:    true,
+							Description: "Kind of the runner, one of `static`, `on-demand` or `local`",
+						},
+						"labels": schema.MapAttribute{
+							Computed:    true,
+							Description: "Labels of the runner, which runner profiles can target",
+							ElementType: types.StringType,
+						},
+						"adoption_state": schema.StringAttribute{
+							Computed:    true,
+							Description: "Adoption state of the runner, one of `PENDING`, `PREADOPTED`, `ADOPTED` or `REJECTED`. Only adopted and preadopted runners are given jobs",
+						},
+						"online": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the runner is currently connected to the server",
+						},
+						"by_id_only": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the runner only accepts jobs that target it by ID",
+						},
+						"first_seen": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the runner first registered, in RFC 3339 format",
+						},
+						"last_seen": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the runner last started, in RFC 3339 format",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *runnersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state runnersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runners, err := d.client.GRPCClient().ListRunners(ctx, &gen.ListRunnersRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Runners",
+			"Could not list runners: "+err.Error(),
+		)
+		return
+	}
+
+	matching := filterRunners(runners.Runners, state.Labels)
+	sort.Slice(matching, func(i, j int) bool { return matching[i].Id < matching[j].Id })
+
+	state.Runners = []runnersItemModel{}
+	for _, runner := range matching {
+		labels, diags := types.MapValueFrom(ctx, types.StringType, runner.Labels)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Runners = append(state.Runners, runnersItemModel{
+			ID:            types.StringValue(runner.Id),
+			Kind:          types.StringValue(runnerKind(runner)),
+			Labels:        labels,
+			AdoptionState: types.StringValue(runner.AdoptionState.String()),
+			Online:        types.BoolValue(runner.Online),
+			ByIDOnly:      types.BoolValue(runner.ByIdOnly),
+			FirstSeen:     flattenTimestamp(runner.FirstSeen),
+			LastSeen:      flattenTimestamp(runner.LastSeen),
+		})
+	}
+	tflog.Debug(ctx, "Listed runners", map[string]interface{}{"count": len(state.Runners)})
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// filterRunners returns the runners that have all of the given labels.
+func filterRunners(runners []*gen.Runner, labels map[string]string) []*gen.Runner {
+	var matching []*gen.Runner
+	for _, runner := range runners {
+		if runnerHasLabels(runner, labels) {
+			matching = append(matching, runner)
+		}
+	}
+
+	return matching
+}
+
+// runnerHasLabels reports whether the runner has all of the given labels.
+func runnerHasLabels(runner *gen.Runner, labels map[string]string) bool {
+	for k, v := range labels {
+		if value, ok := runner.Labels[k]; !ok || value != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// runnerKind returns the kind of a runner. Remote runners are called static
+// runners, as they are by the Waypoint CLI.
+func runnerKind(runner *gen.Runner) string {
+	switch k := runner.Kind.(type) {
+	case *gen.Runner_Odr:
+		return runnerKindOnDemand
+	case *gen.Runner_DeprecatedIsOdr:
+		// The deprecated flag may be set but false, which is not on-demand
+		if k.DeprecatedIsOdr {
+			return runnerKindOnDemand
+		}
+		return runnerKindStatic
+	case *gen.Runner_Local_:
+		return runnerKindLocal
+	default:
+		return runnerKindStatic
+	}
+}
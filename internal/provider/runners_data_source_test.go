@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+)
+
+func TestFilterRunners(t *testing.T) {
+	runners := []*gen.Runner{
+		{Id: "a", Labels: map[string]string{"env": "prod", "region": "eu"}},
+		{Id: "b", Labels: map[string]string{"env": "dev"}},
+		{Id: "c"},
+	}
+
+	cases := map[string]struct {
+		labels map[string]string
+		want   []string
+	}{
+		"no filter":       {labels: nil, want: []string{"a", "b", "c"}},
+		"single label":    {labels: map[string]string{"env": "prod"}, want: []string{"a"}},
+		"all labels":      {labels: map[string]string{"env": "prod", "region": "us"}, want: nil},
+		"different value": {labels: map[string]string{"env": "staging"}, want: nil},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			for _, runner := range filterRunners(runners, tc.labels) {
+				got = append(got, runner.Id)
+			}
+
+			if len(got) != len(tc.want) {
+				t.Fatalf("expected runners %v, got %v", tc.want, got)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Fatalf("expected runners %v, got %v", tc.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRunnerKind(t *testing.T) {
+	cases := map[string]struct {
+		runner *gen.Runner
+		want   string
+	}{
+		"remote":             {&gen.Runner{Kind: &gen.Runner_Remote_{Remote: &gen.Runner_Remote{}}}, runnerKindStatic},
+		"odr":                {&gen.Runner{Kind: &gen.Runner_Odr{Odr: &gen.Runner_ODR{}}}, runnerKindOnDemand},
+		"deprecated odr":     {&gen.Runner{Kind: &gen.Runner_DeprecatedIsOdr{DeprecatedIsOdr: true}}, runnerKindOnDemand},
+		"deprecated not odr": {&gen.Runner{Kind: &gen.Runner_DeprecatedIsOdr{DeprecatedIsOdr: false}}, runnerKindStatic},
+		"local":              {&gen.Runner{Kind: &gen.Runner_Local_{Local: &gen.Runner_Local{}}}, runnerKindLocal},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := runnerKind(tc.runner); got != tc.want {
+				t.Errorf("expected kind %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
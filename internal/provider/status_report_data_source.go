@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Status report targets
+const (
+	statusReportTargetDeployment = "deployment"
+	statusReportTargetRelease    = "release"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &statusReportDataSource{}
+	_ datasource.DataSourceWithConfigure = &statusReportDataSource{}
+)
+
+// NewStatusReportDataSource is a helper function to simplify the provider implementation.
+func NewStatusReportDataSource() datasource.DataSource {
+	return &statusReportDataSource{}
+}
+
+// statusReportDataSource is the data source implementation.
+type statusReportDataSource struct {
+	client waypointClient.Waypoint
+}
+
+// statusReportDataSourceModel maps the schema data.
+type statusReportDataSourceModel struct {
+	Project      types.String `tfsdk:"project_name"`
+	App          types.String `tfsdk:"app_name"`
+	Workspace    types.String `tfsdk:"workspace"`
+	Target       types.String `tfsdk:"target"`
+	DeploymentID types.String `tfsdk:"deployment_id"`
+	ReleaseID    types.String `tfsdk:"release_id"`
+
+	ID             types.String `tfsdk:"id"`
+	Health         types.String `tfsdk:"health"`
+	HealthMessage  types.String `tfsdk:"health_message"`
+	GeneratedTime  types.String `tfsdk:"generated_time"`
+	External       types.Bool   `tfsdk:"external"`
+	InstancesCount types.Int64  `tfsdk:"instances_count"`
+
+	Resources []statusReportResourceModel `tfsdk:"resources"`
+}
+
+// statusReportResourceModel maps the health of a single platform resource.
+type statusReportResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	Platform         types.String `tfsdk:"platform"`
+	PlatformURL      types.String `tfsdk:"platform_url"`
+	ParentResourceID types.String `tfsdk:"parent_resource_id"`
+	Health           types.String `tfsdk:"health"`
+	HealthMessage    types.String `tfsdk:"health_message"`
+	CreatedTime      types.String `tfsdk:"created_time"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *statusReportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(waypointClient.Waypoint)
+}
+
+// Metadata returns the data source type name.
+func (d *statusReportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_report"
+}
+
+// Schema defines the schema for the data source
+func (d *statusReportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up the latest status report of an application's deployment or release, as generated by the application status polling of its project",
+		Attributes: map[string]schema.Attribute{
+			"project_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Waypoint project",
+			},
+			"app_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Waypoint application",
+			},
+			"workspace": schema.StringAttribute{
+				Optional:    true,
+				Description: "The workspace to look up the status report in. Defaults to `default`",
+			},
+			"target": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only look up reports of a `deployment` or a `release`. The latest report of either is returned when unset",
+				Validators: []validator.String{
+					stringvalidator.OneOf(statusReportTargetDeployment, statusReportTargetRelease),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Look up the latest report of this deployment. Set to the reported deployment when a deployment report is found",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("target"),
+						path.MatchRoot("release_id"),
+					}...),
+				},
+			},
+			"release_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Look up the latest report of this release. Set to the reported release when a release report is found",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("target"),
+						path.MatchRoot("deployment_id"),
+					}...),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the status report",
+			},
+			"health": schema.StringAttribute{
+				Computed:    true,
+				Description: "Overall health reported by the platform plugin, one of `UNKNOWN`, `ALIVE`, `READY`, `DOWN`, `PARTIAL` or `MISSING`",
+			},
+			"health_message": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human readable explanation of the overall health",
+			},
+			"generated_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the report was generated, in RFC 3339 format",
+			},
+			"external": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the health check was performed by the platform rather than by Waypoint",
+			},
+			"instances_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of instances connected to the Waypoint server through the entrypoint. Only reported for deployments",
+			},
+			"resources": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Health of the individual platform resources of the deployment or release",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the resource on the platform",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the resource",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Platform specific type of the resource, such as `pod` or `container`",
+						},
+						"platform": schema.StringAttribute{
+							Computed:    true,
+							Description: "Platform the resource exists on",
+						},
+						"platform_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "Link to the resource on the platform, if any",
+						},
+						"parent_resource_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the resource that created this resource, if any",
+						},
+						"health": schema.StringAttribute{
+							Computed:    true,
+							Description: "Health of the resource, one of `UNKNOWN`, `ALIVE`, `READY`, `DOWN`, `PARTIAL` or `MISSING`",
+						},
+						"health_message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Human readable explanation of the health of the resource",
+						},
+						"created_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the platform created the resource, in RFC 3339 format",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *statusReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state statusReportDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := state.Project.ValueString()
+	appName := state.App.ValueString()
+	workspace := workspaceOrDefault(state.Workspace)
+	ctx = tflog.SetField(ctx, "waypoint_project", projectName)
+	ctx = tflog.SetField(ctx, "waypoint_app", appName)
+	ctx = tflog.SetField(ctx, "waypoint_workspace", workspace)
+
+	report, err := d.client.GRPCClient().GetLatestStatusReport(ctx, statusReportRequest(state, workspace))
+	if status.Code(err) == codes.NotFound {
+		resp.Diagnostics.AddError(
+			"No Status Report Found",
+			"Application "+projectName+"/"+appName+" has no matching status report in workspace "+workspace+". "+
+				"Reports are only generated when application status polling is enabled for the project.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Status Report",
+			"Could not read the latest status report of "+projectName+"/"+appName+": "+err.Error(),
+		)
+		return
+	}
+
+	flattenStatusReport(&state, report)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// statusReportRequest builds the request for the latest status report
+// matching the configured target.
+func statusReportRequest(data statusReportDataSourceModel, workspace string) *gen.GetLatestStatusReportRequest {
+	req := &gen.GetLatestStatusReportRequest{
+		Application: &gen.Ref_Application{
+			Project:     data.Project.ValueString(),
+			Application: data.App.ValueString(),
+		},
+		Workspace: &gen.Ref_Workspace{Workspace: workspace},
+	}
+
+	switch {
+	case data.DeploymentID.ValueString() != "":
+		req.Target = &gen.GetLatestStatusReportRequest_DeploymentId{DeploymentId: data.DeploymentID.ValueString()}
+	case data.ReleaseID.ValueString() != "":
+		req.Target = &gen.GetLatestStatusReportRequest_ReleaseId{ReleaseId: data.ReleaseID.ValueString()}
+	case data.Target.ValueString() == statusReportTargetDeployment:
+		req.Target = &gen.GetLatestStatusReportRequest_DeploymentAny{DeploymentAny: &emptypb.Empty{}}
+	case data.Target.ValueString() == statusReportTargetRelease:
+		req.Target = &gen.GetLatestStatusReportRequest_ReleaseAny{ReleaseAny: &emptypb.Empty{}}
+	default:
+		req.Target = &gen.GetLatestStatusReportRequest_Any{Any: &emptypb.Empty{}}
+	}
+
+	return req
+}
+
+// flattenStatusReport sets the computed attributes from a status report.
+func flattenStatusReport(data *statusReportDataSourceModel, report *gen.StatusReport) {
+	data.ID = types.StringValue(report.Id)
+	data.DeploymentID = types.StringNull()
+	data.ReleaseID = types.StringNull()
+	switch target := report.TargetId.(type) {
+	case *gen.StatusReport_DeploymentId:
+		data.DeploymentID = types.StringValue(target.DeploymentId)
+	case *gen.StatusReport_ReleaseId:
+		data.ReleaseID = types.StringValue(target.ReleaseId)
+	}
+
+	data.Health = types.StringValue(report.GetHealth().GetHealthStatus())
+	data.HealthMessage = types.StringValue(report.GetHealth().GetHealthMessage())
+	data.GeneratedTime = flattenTimestamp(report.GeneratedTime)
+	data.External = types.BoolValue(report.External)
+	data.InstancesCount = types.Int64Value(int64(report.InstancesCount))
+
+	data.Resources = []statusReportResourceModel{}
+	for _, r := range report.Resources {
+		data.Resources = append(data.Resources, statusReportResourceModel{
+			ID:               types.StringValue(r.Id),
+			Name:             types.StringValue(r.Name),
+			Type:             types.StringValue(r.Type),
+			Platform:         types.StringValue(r.Platform),
+			PlatformURL:      types.StringValue(r.PlatformUrl),
+			ParentResourceID: types.StringValue(r.ParentResourceId),
+			Health:           types.StringValue(r.Health.String()),
+			HealthMessage:    types.StringValue(r.HealthMessage),
+			CreatedTime:      flattenTimestamp(r.CreatedTime),
+		})
+	}
+}
+
+// flattenTimestamp formats a timestamp in RFC 3339, or returns null when it
+// is not set.
+func flattenTimestamp(ts *timestamppb.Timestamp) types.String {
+	if ts == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(ts.AsTime().Format(time.RFC3339))
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestStatusReportRequest(t *testing.T) {
+	cases := map[string]struct {
+		data statusReportDataSourceModel
+		want *gen.GetLatestStatusReportRequest
+	}{
+		"any": {
+			data: statusReportDataSourceModel{},
+			want: &gen.GetLatestStatusReportRequest{
+				Target: &gen.GetLatestStatusReportRequest_Any{Any: &emptypb.Empty{}},
+			},
+		},
+		"deployment": {
+			data: statusReportDataSourceModel{Target: types.StringValue(statusReportTargetDeployment)},
+			want: &gen.GetLatestStatusReportRequest{
+				Target: &gen.GetLatestStatusReportRequest_DeploymentAny{DeploymentAny: &emptypb.Empty{}},
+			},
+		},
+		"release": {
+			data: statusReportDataSourceModel{Target: types.StringValue(statusReportTargetRelease)},
+			want: &gen.GetLatestStatusReportRequest{
+				Target: &gen.GetLatestStatusReportRequest_ReleaseAny{ReleaseAny: &emptypb.Empty{}},
+			},
+		},
+		"deployment id": {
+			data: statusReportDataSourceModel{DeploymentID: types.StringValue("01GX")},
+			want: &gen.GetLatestStatusReportRequest{
+				Target: &gen.GetLatestStatusReportRequest_DeploymentId{DeploymentId: "01GX"},
+			},
+		},
+		"release id": {
+			data: statusReportDataSourceModel{ReleaseID: types.StringValue("01GY")},
+			want: &gen.GetLatestStatusReportRequest{
+				Target: &gen.GetLatestStatusReportRequest_ReleaseId{ReleaseId: "01GY"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.data.Project = types.StringValue("example")
+			tc.data.App = types.StringValue("web")
+			tc.want.Application = &gen.Ref_Application{Project: "example", Application: "web"}
+			tc.want.Workspace = &gen.Ref_Workspace{Workspace: defaultWorkspace}
+
+			if got := statusReportRequest(tc.data, defaultWorkspace); !proto.Equal(got, tc.want) {
+				t.Errorf("expected request %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFlattenStatusReport(t *testing.T) {
+	generated := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	var data statusReportDataSourceModel
+	flattenStatusReport(&data, &gen.StatusReport{
+		Id:            "01GZ",
+		TargetId:      &gen.StatusReport_DeploymentId{DeploymentId: "01GX"},
+		Health:        &gen.StatusReport_Health{HealthStatus: "READY", HealthMessage: "all pods ready"},
+		GeneratedTime: timestamppb.New(generated),
+		Resources: []*gen.StatusReport_Resource{
+			{Id: "pod-1", Name: "web-1", Type: "pod", Health: gen.StatusReport_Resource_DOWN},
+		},
+	})
+
+	if data.DeploymentID.ValueString() != "01GX" || !data.ReleaseID.IsNull() {
+		t.Errorf("expected deployment 01GX and no release, got %v and %v", data.DeploymentID, data.ReleaseID)
+	}
+	if data.Health.ValueString() != "READY" || data.GeneratedTime.ValueString() != "2023-05-01T12:00:00Z" {
+		t.Errorf("unexpected health %v or time %v", data.Health, data.GeneratedTime)
+	}
+	if len(data.Resources) != 1 || data.Resources[0].Health.ValueString() != "DOWN" || !data.Resources[0].CreatedTime.IsNull() {
+		t.Errorf("unexpected resources %v", data.Resources)
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-waypoint/internal/timetypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &tokenInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &tokenInfoDataSource{}
+)
+
+// NewTokenInfoDataSource is a helper function to simplify the provider implementation.
+func NewTokenInfoDataSource() datasource.DataSource {
+	return &tokenInfoDataSource{}
+}
+
+// tokenInfoDataSource is the data source implementation.
+type tokenInfoDataSource struct {
+	client *providerClient
+}
+
+// tokenInfoDataSourceModel maps the schema data.
+type tokenInfoDataSourceModel struct {
+	Token      types.String         `tfsdk:"token"`
+	WarnBefore timetypes.GoDuration `tfsdk:"warn_before"`
+
+	ID        types.String `tfsdk:"id"`
+	Kind      types.String `tfsdk:"kind"`
+	UserID    types.String `tfsdk:"user_id"`
+	Username  types.String `tfsdk:"username"`
+	IssuedAt  types.String `tfsdk:"issued_at"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *tokenInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*providerClient)
+}
+
+// Metadata returns the data source type name.
+func (d *tokenInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token_info"
+}
+
+// Schema defines the schema for the data source
+func (d *tokenInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Inspects a Waypoint token, by default the one the provider is configured with",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The token to inspect. Defaults to the provider's token",
+			},
+			"warn_before": schema.StringAttribute{
+				CustomType:  timetypes.GoDurationType{},
+				Optional:    true,
+				Description: "Warn when the token expires within this duration, such as `168h`",
+				Validators: []validator.String{
+					timetypes.GoDurationAtLeast(time.Second),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Accessor ID of the token, which identifies it without revealing it",
+			},
+			"kind": schema.StringAttribute{
+				Computed:    true,
+				Description: "Kind of token, one of `login`, `runner`, `invite` or `trigger`",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the user a login token authenticates as, or the user who issued an invite or trigger token",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "Username of the user identified by user_id. Null when the user no longer exists",
+			},
+			"issued_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the token was issued, in RFC 3339 format",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the token expires, in RFC 3339 format. Null when the token never expires",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *tokenInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state tokenInfoDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token := d.client.token
+	if !state.Token.IsNull() {
+		token = state.Token.ValueString()
+	}
+
+	summary, err := describeToken(ctx, d.client.GRPCClient(), token)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Token",
+			"Could not decode token: "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = summary.AccessorID
+	state.Kind = summary.Kind
+	state.UserID = summary.UserID
+	state.IssuedAt = summary.IssuedAt
+	state.ExpiresAt = summary.ExpiresAt
+
+	state.Username = types.StringNull()
+	if !summary.UserID.IsNull() {
+		user, err := d.client.GRPCClient().GetUser(ctx, &gen.GetUserRequest{
+			User: userByID(summary.UserID.ValueString()),
+		})
+		if err != nil && status.Code(err) != codes.NotFound {
+			resp.Diagnostics.AddError(
+				"Error Reading User",
+				"Could not read User with ID "+summary.UserID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if err == nil {
+			state.Username = types.StringValue(user.GetUser().GetUsername())
+		}
+	}
+
+	if !state.WarnBefore.IsNull() {
+		window, diags := state.WarnBefore.ValueGoDuration()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(checkTokenExpiry(summary, window)...)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-waypoint/internal/timetypes"
+)
+
+// Token types
+const (
+	tokenTypeLogin  = "login"
+	tokenTypeInvite = "invite"
+)
+
+// defaultInviteTTL is how long invite tokens are valid for when ttl is not
+// set, matching the Waypoint CLI.
+const defaultInviteTTL = 24 * time.Hour
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &tokenResource{}
+	_ resource.ResourceWithConfigure      = &tokenResource{}
+	_ resource.ResourceWithValidateConfig = &tokenResource{}
+	_ resource.ResourceWithModifyPlan     = &tokenResource{}
+)
+
+// NewTokenResource is a helper function to simplify the provider implementation.
+func NewTokenResource() resource.Resource {
+	return &tokenResource{}
+}
+
+// tokenResource is the resource implementation.
+type tokenResource struct {
+	client waypointClient.Waypoint
+}
+
+// tokenResourceModel maps the resource schema data.
+type tokenResourceModel struct {
+	ID          types.String         `tfsdk:"id"`
+	Type        types.String         `tfsdk:"type"`
+	Username    types.String         `tfsdk:"username"`
+	Trigger     types.Bool           `tfsdk:"trigger"`
+	TTL         timetypes.GoDuration `tfsdk:"ttl"`
+	RenewBefore timetypes.GoDuration `tfsdk:"renew_before"`
+	Token       types.String         `tfsdk:"token"`
+	IssuedAt    types.String         `tfsdk:"issued_at"`
+	ExpiresAt   types.String         `tfsdk:"expires_at"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *tokenResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(waypointClient.Waypoint)
+}
+
+// Metadata returns the resource type name.
+func (r *tokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token"
+}
+
+// Schema defines the schema for the resource.
+func (r *tokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a Waypoint login or invite token. The Waypoint API cannot revoke tokens, so destroying the resource only removes the token from Terraform state",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Accessor ID of the token, which identifies it without revealing it",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of token, either `login` to authenticate as a user or `invite` to let a new user sign up",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(tokenTypeLogin, tokenTypeInvite),
+				},
+			},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Description: "For login tokens, the user to log in as, which requires the provider to be authenticated as a management user. Defaults to the provider's user. For invite tokens, the username the new user is given, with a random number appended if it is taken. A random username is given when unset",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trigger": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only allow a login token to be used to run triggers. Waypoint has no other token scoping, such as to entrypoints",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.StringAttribute{
+				CustomType:  timetypes.GoDurationType{},
+				Optional:    true,
+				Description: "How long the token is valid for, as a duration such as `1h` or `720h`. Login tokens never expire when unset, and invite tokens default to `24h`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					timetypes.GoDurationAtLeast(time.Second),
+				},
+			},
+			"renew_before": schema.StringAttribute{
+				CustomType:  timetypes.GoDurationType{},
+				Optional:    true,
+				Description: "Issue a new token on the next apply once the token expires within this duration, such as `24h`. Without it, a new token is only issued once the token has expired",
+				Validators: []validator.String{
+					timetypes.GoDurationAtLeast(time.Second),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issued_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the token was issued, in RFC 3339 format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the token expires, in RFC 3339 format. Null when the token never expires",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Generating Token")
+	// Retrieve values from plan
+	var plan tokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var duration string
+	if !plan.TTL.IsNull() {
+		ttl, diags := plan.TTL.ValueGoDuration()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		duration = ttl.String()
+	}
+
+	var token *gen.NewTokenResponse
+	var err error
+	switch plan.Type.ValueString() {
+	case tokenTypeLogin:
+		loginReq := &gen.LoginTokenRequest{
+			Duration: duration,
+			Trigger:  plan.Trigger.ValueBool(),
+		}
+		if !plan.Username.IsNull() {
+			loginReq.User = &gen.Ref_User{
+				Ref: &gen.Ref_User_Username{
+					Username: &gen.Ref_UserUsername{Username: plan.Username.ValueString()},
+				},
+			}
+		}
+		token, err = r.client.GRPCClient().GenerateLoginToken(ctx, loginReq)
+	case tokenTypeInvite:
+		if duration == "" {
+			duration = defaultInviteTTL.String()
+		}
+		token, err = r.client.GRPCClient().GenerateInviteToken(ctx, &gen.InviteTokenRequest{
+			Duration: duration,
+			Signup: &gen.Token_Invite_Signup{
+				InitialUsername: plan.Username.ValueString(),
+			},
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Generating Token",
+			"Could not generate "+plan.Type.ValueString()+" token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	summary, err := describeToken(ctx, r.client.GRPCClient(), token.Token)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Decoding Token",
+			"Could not decode the generated token: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = summary.AccessorID
+	plan.Token = types.StringValue(token.Token)
+	plan.IssuedAt = summary.IssuedAt
+	plan.ExpiresAt = summary.ExpiresAt
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data. Tokens are not
+// stored by the server, so there is nothing to refresh. Renewal is planned
+// by ModifyPlan.
+func (r *tokenResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+// ModifyPlan replaces the token once it expires within renew_before, or has
+// expired, so the next apply issues a new one.
+func (r *tokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to renew when creating or destroying the token
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var expiresAt types.String
+	diags := req.State.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)
+	resp.Diagnostics.Append(diags...)
+	var renewBefore timetypes.GoDuration
+	diags = req.Plan.GetAttribute(ctx, path.Root("renew_before"), &renewBefore)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An unknown renew_before is checked once it is known
+	if renewBefore.IsUnknown() {
+		return
+	}
+
+	var window time.Duration
+	if !renewBefore.IsNull() {
+		window, diags = renewBefore.ValueGoDuration()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	renew, err := tokenExpiresWithin(expiresAt, window, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Planning Token",
+			"Could not parse expires_at: "+err.Error(),
+		)
+		return
+	}
+	if !renew {
+		return
+	}
+
+	tflog.Info(ctx, "Token is due for renewal, planning a new token", map[string]interface{}{"expires_at": expiresAt.ValueString()})
+	for _, name := range []string{"id", "token", "issued_at", "expires_at"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.StringUnknown())...)
+	}
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+}
+
+// Update updates the resource and sets the updated Terraform state on
+// success. Only renew_before can change without issuing a new token.
+func (r *tokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan tokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *tokenResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Info(ctx, "Tokens cannot be revoked, removing from state only")
+}
+
+func (r *tokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data tokenResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.ValueString() == tokenTypeInvite && !data.Trigger.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("trigger"),
+			"Invalid Attribute Combination",
+			"trigger can only be set for login tokens.",
+		)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTokenResourceModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := NewTokenResource()
+	s := testResourceSchema(r)
+
+	cases := map[string]struct {
+		expiresIn   time.Duration
+		renewBefore string
+		wantReplace bool
+	}{
+		"valid":                {expiresIn: 72 * time.Hour, renewBefore: "24h"},
+		"within renew_before":  {expiresIn: 12 * time.Hour, renewBefore: "24h", wantReplace: true},
+		"expired":              {expiresIn: -time.Hour, wantReplace: true},
+		"valid without window": {expiresIn: time.Hour},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			renewBefore := tftypes.NewValue(tftypes.String, nil)
+			if tc.renewBefore != "" {
+				renewBefore = tftypes.NewValue(tftypes.String, tc.renewBefore)
+			}
+			attributes := map[string]tftypes.Value{
+				"id":           tftypes.NewValue(tftypes.String, "01ab"),
+				"type":         tftypes.NewValue(tftypes.String, tokenTypeLogin),
+				"renew_before": renewBefore,
+				"token":        tftypes.NewValue(tftypes.String, "secret"),
+				"issued_at":    tftypes.NewValue(tftypes.String, time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)),
+				"expires_at":   tftypes.NewValue(tftypes.String, time.Now().Add(tc.expiresIn).UTC().Format(time.RFC3339)),
+			}
+			state := testResourceValue(s, attributes)
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: s, Raw: state},
+				Plan:  tfsdk.Plan{Schema: s, Raw: state},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			if replace := len(resp.RequiresReplace) > 0; replace != tc.wantReplace {
+				t.Fatalf("expected replacement to be %t, got %v", tc.wantReplace, resp.RequiresReplace)
+			}
+			var token types.String
+			resp.Plan.GetAttribute(ctx, path.Root("token"), &token)
+			if token.IsUnknown() != tc.wantReplace {
+				t.Errorf("expected token unknown to be %t, got %v", tc.wantReplace, token)
+			}
+		})
+	}
+
+	// Creating a token has no state to renew
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+		Plan:  tfsdk.Plan{Schema: s, Raw: testResourceValue(s, nil)},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() || len(resp.RequiresReplace) > 0 {
+		t.Errorf("expected no replacement on create, got %v (%v)", resp.RequiresReplace, resp.Diagnostics)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Token kinds
+const (
+	tokenKindLogin   = "login"
+	tokenKindRunner  = "runner"
+	tokenKindInvite  = "invite"
+	tokenKindTrigger = "trigger"
+)
+
+// tokenSummary is what the server reports about a token it issued.
+type tokenSummary struct {
+	AccessorID types.String
+	Kind       types.String
+	UserID     types.String
+	IssuedAt   types.String
+	ExpiresAt  types.String
+}
+
+// describeToken decodes a token with the server. The accessor ID identifies
+// the token without revealing it, and the expiry is null for tokens that
+// never expire.
+func describeToken(ctx context.Context, client gen.WaypointClient, token string) (tokenSummary, error) {
+	resp, err := client.DecodeToken(ctx, &gen.DecodeTokenRequest{Token: token})
+	if err != nil {
+		return tokenSummary{}, err
+	}
+
+	decoded := resp.GetToken()
+	kind, userID := tokenKind(decoded)
+
+	summary := tokenSummary{
+		AccessorID: types.StringValue(hex.EncodeToString(decoded.GetAccessorId())),
+		Kind:       types.StringValue(kind),
+		UserID:     types.StringNull(),
+		IssuedAt:   flattenTimestamp(decoded.GetIssuedTime()),
+		ExpiresAt:  flattenTimestamp(decoded.GetValidUntil()),
+	}
+	if userID != "" {
+		summary.UserID = types.StringValue(userID)
+	}
+
+	return summary, nil
+}
+
+// tokenKind returns the kind of a token and the ID of the user it logs in
+// as, or for invite and trigger tokens, the user who issued it.
+func tokenKind(token *gen.Token) (kind, userID string) {
+	switch k := token.GetKind().(type) {
+	case *gen.Token_Login_:
+		return tokenKindLogin, k.Login.GetUserId()
+	case *gen.Token_Runner_:
+		return tokenKindRunner, ""
+	case *gen.Token_Invite_:
+		return tokenKindInvite, k.Invite.GetFromUserId()
+	case *gen.Token_Trigger_:
+		return tokenKindTrigger, k.Trigger.GetFromUserId()
+	}
+
+	// Tokens issued by older servers only carry the deprecated flags
+	if token.GetUnusedInvite() {
+		return tokenKindInvite, ""
+	}
+	return tokenKindLogin, ""
+}
+
+// tokenExpiresWithin reports whether a token expiring at expiresAt, in RFC
+// 3339 format, expires within window of now. Tokens that never expire never
+// do.
+func tokenExpiresWithin(expiresAt types.String, window time.Duration, now time.Time) (bool, error) {
+	if expiresAt.IsNull() {
+		return false, nil
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err != nil {
+		return false, err
+	}
+
+	return !now.Add(window).Before(expiry), nil
+}
+
+// checkTokenExpiry warns when the token expires within window.
+func checkTokenExpiry(summary tokenSummary, window time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	expiring, err := tokenExpiresWithin(summary.ExpiresAt, window, time.Now())
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Inspect Waypoint Token",
+			"Could not parse the expiry of the Waypoint token: "+err.Error(),
+		)
+		return diags
+	}
+	if expiring {
+		diags.AddWarning(
+			"Waypoint Token Expiring",
+			"The Waypoint token "+summary.AccessorID.ValueString()+" expires at "+summary.ExpiresAt.ValueString()+
+				", within "+window.String()+". Issue a new token before it expires.",
+		)
+	}
+
+	return diags
+}
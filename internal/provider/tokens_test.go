@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testTokensClient decodes every token to the same fixed token.
+type testTokensClient struct {
+	gen.WaypointClient
+	token *gen.Token
+}
+
+func (c *testTokensClient) DecodeToken(context.Context, *gen.DecodeTokenRequest, ...grpc.CallOption) (*gen.DecodeTokenResponse, error) {
+	return &gen.DecodeTokenResponse{Token: c.token}, nil
+}
+
+func TestDescribeToken(t *testing.T) {
+	issued := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	client := &testTokensClient{token: &gen.Token{
+		AccessorId: []byte{0x01, 0xab},
+		IssuedTime: timestamppb.New(issued),
+		Kind:       &gen.Token_Login_{Login: &gen.Token_Login{UserId: "01GU"}},
+	}}
+
+	summary, err := describeToken(context.Background(), client, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.AccessorID.ValueString() != "01ab" || summary.IssuedAt.ValueString() != "2023-05-01T12:00:00Z" {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+	if summary.Kind.ValueString() != tokenKindLogin || summary.UserID.ValueString() != "01GU" {
+		t.Errorf("expected a login token for user 01GU, got %v for %v", summary.Kind, summary.UserID)
+	}
+	if !summary.ExpiresAt.IsNull() {
+		t.Errorf("expected no expiry for a token without one, got %v", summary.ExpiresAt)
+	}
+
+	client.token.Kind = &gen.Token_Runner_{Runner: &gen.Token_Runner{}}
+	client.token.ValidUntil = timestamppb.New(issued.Add(time.Hour))
+	summary, err = describeToken(context.Background(), client, "token")
+	if err != nil || summary.ExpiresAt.ValueString() != "2023-05-01T13:00:00Z" {
+		t.Errorf("expected expiry 2023-05-01T13:00:00Z, got %v (%v)", summary.ExpiresAt, err)
+	}
+	if summary.Kind.ValueString() != tokenKindRunner || !summary.UserID.IsNull() {
+		t.Errorf("expected a runner token without a user, got %v for %v", summary.Kind, summary.UserID)
+	}
+}
+
+func TestTokenExpiresWithin(t *testing.T) {
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		expiresAt types.String
+		window    time.Duration
+		want      bool
+	}{
+		"never expires":         {types.StringNull(), time.Hour, false},
+		"valid":                 {types.StringValue("2023-05-02T12:00:00Z"), 0, false},
+		"expired":               {types.StringValue("2023-05-01T11:00:00Z"), 0, true},
+		"outside window":        {types.StringValue("2023-05-02T12:00:00Z"), 12 * time.Hour, false},
+		"within window":         {types.StringValue("2023-05-02T12:00:00Z"), 36 * time.Hour, true},
+		"window ends at expiry": {types.StringValue("2023-05-02T12:00:00Z"), 24 * time.Hour, true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tokenExpiresWithin(tc.expiresAt, tc.window, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+
+	if _, err := tokenExpiresWithin(types.StringValue("tomorrow"), 0, now); err == nil {
+		t.Error("expected an error for an invalid expiry")
+	}
+}
@@ -0,0 +1,453 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-waypoint/internal/defaults"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Trigger operations
+const (
+	triggerOperationBuild        = "build"
+	triggerOperationPush         = "push"
+	triggerOperationDeploy       = "deploy"
+	triggerOperationRelease      = "release"
+	triggerOperationUp           = "up"
+	triggerOperationDestroy      = "destroy"
+	triggerOperationInit         = "init"
+	triggerOperationStatusReport = "status_report"
+)
+
+// Ports the Waypoint server serves its gRPC and HTTP APIs on by default.
+const (
+	defaultGRPCPort = "9701"
+	defaultHTTPPort = "9702"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &triggerResource{}
+	_ resource.ResourceWithConfigure  = &triggerResource{}
+	_ resource.ResourceWithModifyPlan = &triggerResource{}
+)
+
+// NewTriggerResource is a helper function to simplify the provider implementation.
+func NewTriggerResource() resource.Resource {
+	return &triggerResource{}
+}
+
+// triggerResource is the resource implementation.
+type triggerResource struct {
+	client *providerClient
+}
+
+// triggerResourceModel maps the resource schema data.
+type triggerResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Operation     types.String `tfsdk:"operation"`
+	Project       types.String `tfsdk:"project"`
+	Application   types.String `tfsdk:"application"`
+	Workspace     types.String `tfsdk:"workspace"`
+	Tags          types.Set    `tfsdk:"tags"`
+	Authenticated types.Bool   `tfsdk:"authenticated"`
+	ServerURL     types.String `tfsdk:"server_url"`
+	URL           types.String `tfsdk:"url"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *triggerResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerClient)
+}
+
+// Metadata returns the resource type name.
+func (r *triggerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trigger"
+}
+
+// Schema defines the schema for the resource.
+func (r *triggerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a Waypoint trigger URL, which runs an operation on a project when it is requested",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the trigger, which is part of its URL",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the trigger. Waypoint generates one when unset",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of what the trigger is for",
+			},
+			"operation": schema.StringAttribute{
+				Required: true,
+				Description: "Operation the trigger runs, one of `build`, `push`, `deploy`, `release`, `up`, " +
+					"`destroy`, `init` or `status_report`. Deployments and releases use the latest artifact or deployment",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						triggerOperationBuild,
+						triggerOperationPush,
+						triggerOperationDeploy,
+						triggerOperationRelease,
+						triggerOperationUp,
+						triggerOperationDestroy,
+						triggerOperationInit,
+						triggerOperationStatusReport,
+					),
+				},
+			},
+			"project": schema.StringAttribute{
+				Required:    true,
+				Description: "Project the operation runs on",
+			},
+			"application": schema.StringAttribute{
+				Optional:    true,
+				Description: "Application the operation runs on. The operation runs on every application of the project when unset",
+			},
+			"workspace": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Workspace the operation runs in. Defaults to `default`",
+				PlanModifiers: []planmodifier.String{
+					defaults.StringDefaultValue(types.StringValue(defaultWorkspace)),
+				},
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				Description: "Tags to group related triggers. Waypoint triggers have no labels, so tags are the only way to group them",
+				ElementType: types.StringType,
+			},
+			"authenticated": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Require a Waypoint token to run the trigger. Defaults to true",
+				PlanModifiers: []planmodifier.Bool{
+					defaults.BoolDefaultValue(types.BoolValue(true)),
+				},
+			},
+			"server_url": schema.StringAttribute{
+				Optional: true,
+				Description: "Base URL of the Waypoint server's HTTP API used to build url, such as `https://waypoint.example.com:9702`. " +
+					"Defaults to the provider host over HTTPS, with the default gRPC port 9701 replaced by the HTTP port 9702",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL that runs the trigger",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *triggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Trigger")
+	// Retrieve values from plan
+	var plan triggerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.upsert(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *triggerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state triggerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "waypoint_trigger", state.ID.ValueString())
+
+	trigger, err := r.client.GRPCClient().GetTrigger(ctx, &gen.GetTriggerRequest{
+		Ref: &gen.Ref_Trigger{Id: state.ID.ValueString()},
+	})
+	if status.Code(err) == codes.NotFound {
+		tflog.Info(ctx, "Trigger not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Trigger",
+			"Could not read Trigger with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.flatten(ctx, &state, trigger.GetTrigger())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *triggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan triggerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.upsert(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ModifyPlan marks url unknown when server_url changes, as it is built from
+// server_url and otherwise kept from state.
+func (r *triggerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to keep when creating or destroying the trigger
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var stateServerURL, planServerURL types.String
+	diags := req.State.GetAttribute(ctx, path.Root("server_url"), &stateServerURL)
+	resp.Diagnostics.Append(diags...)
+	diags = req.Plan.GetAttribute(ctx, path.Root("server_url"), &planServerURL)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planServerURL.Equal(stateServerURL) {
+		diags = resp.Plan.SetAttribute(ctx, path.Root("url"), types.StringUnknown())
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *triggerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state triggerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.GRPCClient().DeleteTrigger(ctx, &gen.DeleteTriggerRequest{
+		Ref: &gen.Ref_Trigger{Id: state.ID.ValueString()},
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		resp.Diagnostics.AddError(
+			"Error Deleting Trigger",
+			"Could not delete Trigger with ID "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// upsert creates or updates the trigger described by data, and sets the
+// computed attributes of data from the result.
+func (r *triggerResource) upsert(ctx context.Context, data *triggerResourceModel, diags *diag.Diagnostics) {
+	trigger, d := expandTrigger(ctx, data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	resp, err := r.client.GRPCClient().UpsertTrigger(ctx, &gen.UpsertTriggerRequest{Trigger: trigger})
+	if err != nil {
+		diags.AddError(
+			"Error Saving Trigger",
+			"Could not save Trigger for project "+data.Project.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags.Append(r.flatten(ctx, data, resp.GetTrigger())...)
+}
+
+// flatten sets the attributes of data from trigger.
+func (r *triggerResource) flatten(ctx context.Context, data *triggerResourceModel, trigger *gen.Trigger) diag.Diagnostics {
+	diags := flattenTrigger(ctx, data, trigger)
+
+	base := data.ServerURL.ValueString()
+	if data.ServerURL.IsNull() {
+		base = defaultServerURL(r.client.host)
+	}
+	data.URL = types.StringValue(triggerURL(base, trigger.GetId()))
+
+	return diags
+}
+
+// expandTrigger converts the resource model to a trigger.
+func expandTrigger(ctx context.Context, data *triggerResourceModel) (*gen.Trigger, diag.Diagnostics) {
+	trigger := &gen.Trigger{
+		Id:            data.ID.ValueString(),
+		Name:          data.Name.ValueString(),
+		Description:   data.Description.ValueString(),
+		Authenticated: data.Authenticated.ValueBool(),
+		Project:       &gen.Ref_Project{Project: data.Project.ValueString()},
+		Workspace:     &gen.Ref_Workspace{Workspace: workspaceOrDefault(data.Workspace)},
+	}
+	if data.ID.IsUnknown() {
+		trigger.Id = ""
+	}
+	if data.Name.IsUnknown() {
+		trigger.Name = ""
+	}
+	if !data.Application.IsNull() {
+		trigger.Application = &gen.Ref_Application{
+			Project:     data.Project.ValueString(),
+			Application: data.Application.ValueString(),
+		}
+	}
+
+	diags := data.Tags.ElementsAs(ctx, &trigger.Tags, false)
+
+	switch data.Operation.ValueString() {
+	case triggerOperationBuild:
+		trigger.Operation = &gen.Trigger_Build{Build: &gen.Job_BuildOp{}}
+	case triggerOperationPush:
+		trigger.Operation = &gen.Trigger_Push{Push: &gen.Job_PushOp{}}
+	case triggerOperationDeploy:
+		trigger.Operation = &gen.Trigger_Deploy{Deploy: &gen.Job_DeployOp{}}
+	case triggerOperationRelease:
+		trigger.Operation = &gen.Trigger_Release{Release: &gen.Job_ReleaseOp{}}
+	case triggerOperationUp:
+		trigger.Operation = &gen.Trigger_Up{Up: &gen.Job_UpOp{}}
+	case triggerOperationDestroy:
+		trigger.Operation = &gen.Trigger_Destroy{Destroy: &gen.Job_DestroyOp{}}
+	case triggerOperationInit:
+		trigger.Operation = &gen.Trigger_Init{Init: &gen.Job_InitOp{}}
+	case triggerOperationStatusReport:
+		trigger.Operation = &gen.Trigger_StatusReport{StatusReport: &gen.Job_StatusReportOp{}}
+	}
+
+	return trigger, diags
+}
+
+// flattenTrigger sets the attributes of data from trigger, except for url.
+func flattenTrigger(ctx context.Context, data *triggerResourceModel, trigger *gen.Trigger) diag.Diagnostics {
+	data.ID = types.StringValue(trigger.GetId())
+	data.Name = types.StringValue(trigger.GetName())
+	data.Authenticated = types.BoolValue(trigger.GetAuthenticated())
+	data.Project = types.StringValue(trigger.GetProject().GetProject())
+	data.Workspace = types.StringValue(defaultWorkspace)
+	if trigger.GetWorkspace().GetWorkspace() != "" {
+		data.Workspace = types.StringValue(trigger.GetWorkspace().GetWorkspace())
+	}
+
+	data.Description = types.StringNull()
+	if trigger.GetDescription() != "" {
+		data.Description = types.StringValue(trigger.GetDescription())
+	}
+
+	data.Application = types.StringNull()
+	if trigger.GetApplication().GetApplication() != "" {
+		data.Application = types.StringValue(trigger.GetApplication().GetApplication())
+	}
+
+	switch trigger.GetOperation().(type) {
+	case *gen.Trigger_Build:
+		data.Operation = types.StringValue(triggerOperationBuild)
+	case *gen.Trigger_Push:
+		data.Operation = types.StringValue(triggerOperationPush)
+	case *gen.Trigger_Deploy:
+		data.Operation = types.StringValue(triggerOperationDeploy)
+	case *gen.Trigger_Release:
+		data.Operation = types.StringValue(triggerOperationRelease)
+	case *gen.Trigger_Up:
+		data.Operation = types.StringValue(triggerOperationUp)
+	case *gen.Trigger_Destroy:
+		data.Operation = types.StringValue(triggerOperationDestroy)
+	case *gen.Trigger_Init:
+		data.Operation = types.StringValue(triggerOperationInit)
+	case *gen.Trigger_StatusReport:
+		data.Operation = types.StringValue(triggerOperationStatusReport)
+	}
+
+	// Keep unset tags null, Waypoint does not distinguish them from no tags
+	if len(trigger.GetTags()) == 0 && data.Tags.IsNull() {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	data.Tags, diags = types.SetValueFrom(ctx, types.StringType, append([]string{}, trigger.GetTags()...))
+	return diags
+}
+
+// defaultServerURL returns the base URL of the HTTP API of the Waypoint
+// server at host, the gRPC address the provider connects to.
+func defaultServerURL(host string) string {
+	hostname, port, err := net.SplitHostPort(host)
+	if err != nil {
+		return "https://" + host
+	}
+	if port == defaultGRPCPort {
+		port = defaultHTTPPort
+	}
+
+	return "https://" + net.JoinHostPort(hostname, port)
+}
+
+// triggerURL returns the URL that runs the trigger with the given ID.
+func triggerURL(base, id string) string {
+	return strings.TrimSuffix(base, "/") + "/v1/trigger/" + id
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestExpandTrigger(t *testing.T) {
+	data := &triggerResourceModel{
+		ID:            types.StringUnknown(),
+		Name:          types.StringUnknown(),
+		Description:   types.StringNull(),
+		Operation:     types.StringValue(triggerOperationUp),
+		Project:       types.StringValue("payments"),
+		Application:   types.StringValue("api"),
+		Workspace:     types.StringValue("staging"),
+		Tags:          types.SetValueMust(types.StringType, []attr.Value{types.StringValue("ci")}),
+		Authenticated: types.BoolValue(true),
+	}
+
+	trigger, diags := expandTrigger(context.Background(), data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if trigger.Id != "" || trigger.Name != "" {
+		t.Errorf("expected no ID or name for a new trigger, got %q and %q", trigger.Id, trigger.Name)
+	}
+	if _, ok := trigger.Operation.(*gen.Trigger_Up); !ok {
+		t.Errorf("expected an up operation, got %T", trigger.Operation)
+	}
+	if trigger.Application.GetProject() != "payments" || trigger.Application.GetApplication() != "api" {
+		t.Errorf("unexpected application %v", trigger.Application)
+	}
+	if trigger.Workspace.GetWorkspace() != "staging" || len(trigger.Tags) != 1 || trigger.Tags[0] != "ci" {
+		t.Errorf("unexpected trigger %v", trigger)
+	}
+
+	var state triggerResourceModel
+	state.Tags = types.SetNull(types.StringType)
+	trigger.Id = "01GT"
+	trigger.Name = "generated"
+	if diags := flattenTrigger(context.Background(), &state, trigger); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if state.ID.ValueString() != "01GT" || state.Operation.ValueString() != triggerOperationUp || !state.Description.IsNull() {
+		t.Errorf("unexpected state %+v", state)
+	}
+	if len(state.Tags.Elements()) != 1 {
+		t.Errorf("expected one tag, got %v", state.Tags)
+	}
+}
+
+func TestTriggerURL(t *testing.T) {
+	cases := map[string]string{
+		"localhost:9701":           "https://localhost:9702/v1/trigger/01GT",
+		"waypoint.example.com:443": "https://waypoint.example.com:443/v1/trigger/01GT",
+		"waypoint.example.com":     "https://waypoint.example.com/v1/trigger/01GT",
+		"[2001:db8::1]:9701":       "https://[2001:db8::1]:9702/v1/trigger/01GT",
+	}
+	for host, want := range cases {
+		if got := triggerURL(defaultServerURL(host), "01GT"); got != want {
+			t.Errorf("host %s: expected %s, got %s", host, want, got)
+		}
+	}
+
+	if got := triggerURL("https://waypoint.example.com/", "01GT"); got != "https://waypoint.example.com/v1/trigger/01GT" {
+		t.Errorf("unexpected URL %s", got)
+	}
+}
+
+func TestTriggerResourceModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := NewTriggerResource()
+	s := testResourceSchema(r)
+
+	state := testResourceValue(s, map[string]tftypes.Value{
+		"id":         tftypes.NewValue(tftypes.String, "01ab"),
+		"project":    tftypes.NewValue(tftypes.String, "example"),
+		"server_url": tftypes.NewValue(tftypes.String, "https://waypoint.example.com:9702"),
+		"url":        tftypes.NewValue(tftypes.String, "https://waypoint.example.com:9702/v1/trigger/01ab"),
+	})
+
+	cases := map[string]struct {
+		serverURL   tftypes.Value
+		wantUnknown bool
+	}{
+		"unchanged":      {serverURL: tftypes.NewValue(tftypes.String, "https://waypoint.example.com:9702")},
+		"changed":        {serverURL: tftypes.NewValue(tftypes.String, "https://other.example.com"), wantUnknown: true},
+		"removed":        {serverURL: tftypes.NewValue(tftypes.String, nil), wantUnknown: true},
+		"unknown config": {serverURL: tftypes.NewValue(tftypes.String, tftypes.UnknownValue), wantUnknown: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			plan := testResourceValue(s, map[string]tftypes.Value{
+				"id":         tftypes.NewValue(tftypes.String, "01ab"),
+				"project":    tftypes.NewValue(tftypes.String, "example"),
+				"server_url": tc.serverURL,
+				"url":        tftypes.NewValue(tftypes.String, "https://waypoint.example.com:9702/v1/trigger/01ab"),
+			})
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: s, Raw: state},
+				Plan:  tfsdk.Plan{Schema: s, Raw: plan},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			var url types.String
+			resp.Plan.GetAttribute(ctx, path.Root("url"), &url)
+			if url.IsUnknown() != tc.wantUnknown {
+				t.Errorf("expected url unknown to be %t, got %v", tc.wantUnknown, url)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &userDataSource{}
+	_ datasource.DataSourceWithConfigure = &userDataSource{}
+)
+
+// NewUserDataSource is a helper function to simplify the provider implementation.
+func NewUserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+// userDataSource is the data source implementation.
+type userDataSource struct {
+	client waypointClient.Waypoint
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(waypointClient.Waypoint)
+}
+
+// Metadata returns the data source type name.
+func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the data source
+func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := userAttributes()
+	attributes["user_id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "ID of the user to look up",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("username")),
+		},
+	}
+	attributes["username"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Username of the user to look up",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Waypoint user by ID or username",
+		Attributes:  attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state userModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ref := userByUsername(state.Username.ValueString())
+	name := state.Username.ValueString()
+	if !state.ID.IsNull() {
+		ref = userByID(state.ID.ValueString())
+		name = state.ID.ValueString()
+	}
+	ctx = tflog.SetField(ctx, "waypoint_user", name)
+
+	user, err := d.client.GRPCClient().GetUser(ctx, &gen.GetUserRequest{User: ref})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading User",
+			"Could not read User "+name+": "+err.Error(),
+		)
+		return
+	}
+
+	state = flattenUser(user.GetUser())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
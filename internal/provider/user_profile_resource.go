@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &userProfileResource{}
+	_ resource.ResourceWithConfigure = &userProfileResource{}
+)
+
+// NewUserProfileResource is a helper function to simplify the provider implementation.
+func NewUserProfileResource() resource.Resource {
+	return &userProfileResource{}
+}
+
+// userProfileResource is the resource implementation.
+type userProfileResource struct {
+	client waypointClient.Waypoint
+}
+
+// userProfileResourceModel maps the resource schema data.
+type userProfileResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Username    types.String `tfsdk:"username"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Email       types.String `tfsdk:"email"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(waypointClient.Waypoint)
+}
+
+// Metadata returns the resource type name.
+func (r *userProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_profile"
+}
+
+// Schema defines the schema for the resource.
+func (r *userProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the profile of an existing Waypoint user. Users are created by logging in or accepting an invite, and cannot be created or deleted through the Waypoint API, so destroying the resource leaves the user and its profile unchanged",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the user",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "Username of the user whose profile is managed",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Display name of the user. Left unchanged when unset",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Email address of the user. Waypoint does not verify it. Left unchanged when unset",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Updating User Profile")
+	// Retrieve values from plan
+	var plan userProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := plan.Username.ValueString()
+	ctx = tflog.SetField(ctx, "waypoint_user", username)
+
+	user, err := r.client.GRPCClient().GetUser(ctx, &gen.GetUserRequest{User: userByUsername(username)})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading User",
+			"Could not read User "+username+", the user must log in or accept an invite before its profile can be managed: "+err.Error(),
+		)
+		return
+	}
+
+	updated, err := r.client.GRPCClient().UpdateUser(ctx, &gen.UpdateUserRequest{
+		User: userProfileUpdate(user.GetUser(), &plan),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating User",
+			"Could not update User "+username+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	flattenUserProfile(&plan, updated.GetUser())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "waypoint_user", state.Username.ValueString())
+
+	user, err := r.client.GRPCClient().GetUser(ctx, &gen.GetUserRequest{User: userByID(state.ID.ValueString())})
+	if status.Code(err) == codes.NotFound {
+		tflog.Info(ctx, "User not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading User",
+			"Could not read User "+state.Username.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	flattenUserProfile(&state, user.GetUser())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *userProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := plan.Username.ValueString()
+	ctx = tflog.SetField(ctx, "waypoint_user", username)
+
+	// Start from the current user, so the display name and email are kept
+	// when those attributes are unset.
+	user, err := r.client.GRPCClient().GetUser(ctx, &gen.GetUserRequest{User: userByID(plan.ID.ValueString())})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading User",
+			"Could not read User "+username+": "+err.Error(),
+		)
+		return
+	}
+
+	updated, err := r.client.GRPCClient().UpdateUser(ctx, &gen.UpdateUserRequest{
+		User: userProfileUpdate(user.GetUser(), &plan),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating User",
+			"Could not update User "+username+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	flattenUserProfile(&plan, updated.GetUser())
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *userProfileResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Info(ctx, "Users cannot be deleted, removing profile from state only")
+}
+
+// userProfileUpdate returns a copy of user with the configured profile
+// fields of data applied.
+func userProfileUpdate(user *gen.User, data *userProfileResourceModel) *gen.User {
+	update := &gen.User{
+		Id:       user.GetId(),
+		Username: user.GetUsername(),
+		Display:  user.GetDisplay(),
+		Email:    user.GetEmail(),
+	}
+	if !data.DisplayName.IsNull() && !data.DisplayName.IsUnknown() {
+		update.Display = data.DisplayName.ValueString()
+	}
+	if !data.Email.IsNull() && !data.Email.IsUnknown() {
+		update.Email = data.Email.ValueString()
+	}
+
+	return update
+}
+
+// flattenUserProfile sets the attributes of data from user. The username is
+// kept as configured, the user is tracked by ID.
+func flattenUserProfile(data *userProfileResourceModel, user *gen.User) {
+	data.ID = types.StringValue(user.GetId())
+	data.DisplayName = types.StringValue(user.GetDisplay())
+	data.Email = types.StringValue(user.GetEmail())
+}
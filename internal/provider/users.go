@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// userModel maps a Waypoint user in data sources.
+type userModel struct {
+	ID          types.String    `tfsdk:"user_id"`
+	Username    types.String    `tfsdk:"username"`
+	DisplayName types.String    `tfsdk:"display_name"`
+	Email       types.String    `tfsdk:"email"`
+	OIDCLinks   []userLinkModel `tfsdk:"oidc_links"`
+}
+
+// userLinkModel maps the link of a user to an OIDC identity.
+type userLinkModel struct {
+	Issuer  types.String `tfsdk:"issuer"`
+	Subject types.String `tfsdk:"subject"`
+}
+
+// userByID returns a reference to the user with the given ID.
+func userByID(id string) *gen.Ref_User {
+	return &gen.Ref_User{
+		Ref: &gen.Ref_User_Id{Id: &gen.Ref_UserId{Id: id}},
+	}
+}
+
+// userByUsername returns a reference to the user with the given username.
+func userByUsername(username string) *gen.Ref_User {
+	return &gen.Ref_User{
+		Ref: &gen.Ref_User_Username{Username: &gen.Ref_UserUsername{Username: username}},
+	}
+}
+
+// userAttributes returns the computed schema of a userModel, except for the
+// attributes that identify the user.
+func userAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"display_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Display name of the user",
+		},
+		"email": schema.StringAttribute{
+			Computed:    true,
+			Description: "Email address of the user, which may not be verified",
+		},
+		"oidc_links": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The OIDC identities the user has logged in with",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"issuer": schema.StringAttribute{
+						Computed:    true,
+						Description: "Issuer of the OIDC identity",
+					},
+					"subject": schema.StringAttribute{
+						Computed:    true,
+						Description: "Subject of the OIDC identity",
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenUser converts a user to its data source model.
+func flattenUser(user *gen.User) userModel {
+	links := []userLinkModel{}
+	for _, link := range user.GetLinks() {
+		if oidc, ok := link.GetMethod().(*gen.User_Link_Oidc); ok {
+			links = append(links, userLinkModel{
+				Issuer:  types.StringValue(oidc.Oidc.GetIss()),
+				Subject: types.StringValue(oidc.Oidc.GetSub()),
+			})
+		}
+	}
+
+	return userModel{
+		ID:          types.StringValue(user.GetId()),
+		Username:    types.StringValue(user.GetUsername()),
+		DisplayName: types.StringValue(user.GetDisplay()),
+		Email:       types.StringValue(user.GetEmail()),
+		OIDCLinks:   links,
+	}
+}
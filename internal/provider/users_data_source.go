@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sort"
+
+	waypointClient "github.com/hashicorp-dev-advocates/waypoint-client/pkg/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+// NewUsersDataSource is a helper function to simplify the provider implementation.
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// usersDataSource is the data source implementation.
+type usersDataSource struct {
+	client waypointClient.Waypoint
+}
+
+// usersDataSourceModel maps the schema data.
+type usersDataSourceModel struct {
+	Users []userModel `tfsdk:"users"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(waypointClient.Waypoint)
+}
+
+// Metadata returns the data source type name.
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := userAttributes()
+	attributes["user_id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "ID of the user",
+	}
+	attributes["username"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Username of the user",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the users of the Waypoint server",
+		Attributes: map[string]schema.Attribute{
+			"users": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The users, ordered by username",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: attributes,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usersDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	users, err := d.client.GRPCClient().ListUsers(ctx, &emptypb.Empty{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Users",
+			"Could not list users: "+err.Error(),
+		)
+		return
+	}
+
+	state := usersDataSourceModel{Users: []userModel{}}
+	for _, user := range users.Users {
+		state.Users = append(state.Users, flattenUser(user))
+	}
+	sort.Slice(state.Users, func(i, j int) bool {
+		return state.Users[i].Username.ValueString() < state.Users[j].Username.ValueString()
+	})
+	tflog.Debug(ctx, "Listed users", map[string]interface{}{"count": len(state.Users)})
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	gen "github.com/hashicorp-dev-advocates/waypoint-client/pkg/waypoint"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFlattenUser(t *testing.T) {
+	user := flattenUser(&gen.User{
+		Id:       "01GU",
+		Username: "alice",
+		Email:    "alice@example.com",
+		Links: []*gen.User_Link{
+			{Method: &gen.User_Link_Oidc{Oidc: &gen.User_Link_OIDC{Iss: "https://idp.example.com", Sub: "1234"}}},
+		},
+	})
+
+	if user.ID.ValueString() != "01GU" || user.Username.ValueString() != "alice" {
+		t.Errorf("unexpected user %+v", user)
+	}
+	if user.DisplayName.IsNull() || user.DisplayName.ValueString() != "" {
+		t.Errorf("expected an empty display name, got %v", user.DisplayName)
+	}
+	if len(user.OIDCLinks) != 1 || user.OIDCLinks[0].Issuer.ValueString() != "https://idp.example.com" || user.OIDCLinks[0].Subject.ValueString() != "1234" {
+		t.Errorf("unexpected OIDC links %+v", user.OIDCLinks)
+	}
+}
+
+func TestUserProfileUpdate(t *testing.T) {
+	user := &gen.User{Id: "01GU", Username: "alice", Display: "Alice", Email: "alice@example.com"}
+
+	update := userProfileUpdate(user, &userProfileResourceModel{
+		DisplayName: types.StringValue("Alice Smith"),
+		Email:       types.StringUnknown(),
+	})
+	if update.Display != "Alice Smith" {
+		t.Errorf("expected the configured display name, got %q", update.Display)
+	}
+	if update.Id != "01GU" || update.Username != "alice" || update.Email != "alice@example.com" {
+		t.Errorf("expected unmanaged fields to be kept, got %+v", update)
+	}
+
+	update = userProfileUpdate(user, &userProfileResourceModel{
+		DisplayName: types.StringNull(),
+		Email:       types.StringValue(""),
+	})
+	if update.Display != "Alice" || update.Email != "" {
+		t.Errorf("expected the email to be cleared only, got %+v", update)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = GoDurationType{}
+	_ xattr.TypeWithValidate  = GoDurationType{}
+)
+
+// GoDurationType is a string type holding a Go duration such as "30s" or
+// "5m", in the format accepted by time.ParseDuration.
+type GoDurationType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t GoDurationType) String() string {
+	return "timetypes.GoDurationType"
+}
+
+// ValueType returns the Value type.
+func (t GoDurationType) ValueType(ctx context.Context) attr.Value {
+	return GoDuration{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t GoDurationType) Equal(o attr.Type) bool {
+	other, ok := o.(GoDurationType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t GoDurationType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return GoDuration{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t GoDurationType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// Validate checks that known values parse as a Go duration.
+func (t GoDurationType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil || !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	if err := in.As(&value); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid Duration Value",
+			"A string value was expected, got: "+err.Error(),
+		)
+		return diags
+	}
+
+	if _, err := time.ParseDuration(value); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid Duration Value",
+			fmt.Sprintf("%q is not a valid duration, expected a value such as \"30s\" or \"5m\": %s", value, err),
+		)
+	}
+
+	return diags
+}
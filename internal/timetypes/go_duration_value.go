@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ basetypes.StringValuable = GoDuration{}
+
+// GoDuration is a string value holding a Go duration.
+type GoDuration struct {
+	basetypes.StringValue
+}
+
+// NewGoDurationNull creates a GoDuration with a null value.
+func NewGoDurationNull() GoDuration {
+	return GoDuration{StringValue: basetypes.NewStringNull()}
+}
+
+// NewGoDurationUnknown creates a GoDuration with an unknown value.
+func NewGoDurationUnknown() GoDuration {
+	return GoDuration{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewGoDurationValue creates a GoDuration with a known value.
+func NewGoDurationValue(value string) GoDuration {
+	return GoDuration{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewGoDurationTimeDuration creates a GoDuration from a time.Duration.
+func NewGoDurationTimeDuration(value time.Duration) GoDuration {
+	return NewGoDurationValue(value.String())
+}
+
+// Type returns a GoDurationType.
+func (v GoDuration) Type(_ context.Context) attr.Type {
+	return GoDurationType{}
+}
+
+// Equal returns true if the given value is exactly equivalent.
+func (v GoDuration) Equal(o attr.Value) bool {
+	other, ok := o.(GoDuration)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both values hold the same duration,
+// such as "5m" and "5m0s", which is how the Waypoint server formats them.
+func (v GoDuration) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(GoDuration)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.",
+		)
+		return false, diags
+	}
+
+	if v.IsNull() || v.IsUnknown() || newValue.IsNull() || newValue.IsUnknown() {
+		return v.Equal(newValue), diags
+	}
+
+	// Values are validated by the type, so parse errors are not expected
+	// here and only mean the values can't be treated as equal.
+	d, err := time.ParseDuration(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	newD, err := time.ParseDuration(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return d == newD, diags
+}
+
+// ValueGoDuration parses the value as a time.Duration. Null and unknown
+// values return zero.
+func (v GoDuration) ValueGoDuration() (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		return 0, diags
+	}
+
+	d, err := time.ParseDuration(v.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Invalid Duration Value",
+			"Could not parse "+v.ValueString()+" as a duration: "+err.Error(),
+		)
+	}
+
+	return d, diags
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGoDurationStringSemanticEquals(t *testing.T) {
+	cases := map[string]struct {
+		value    GoDuration
+		newValue GoDuration
+		expected bool
+	}{
+		"same string": {
+			value:    NewGoDurationValue("30s"),
+			newValue: NewGoDurationValue("30s"),
+			expected: true,
+		},
+		"server format": {
+			value:    NewGoDurationValue("5m"),
+			newValue: NewGoDurationValue("5m0s"),
+			expected: true,
+		},
+		"seconds and minutes": {
+			value:    NewGoDurationValue("90s"),
+			newValue: NewGoDurationValue("1m30s"),
+			expected: true,
+		},
+		"different duration": {
+			value:    NewGoDurationValue("5m"),
+			newValue: NewGoDurationValue("5m1s"),
+			expected: false,
+		},
+		"null and value": {
+			value:    NewGoDurationNull(),
+			newValue: NewGoDurationValue("0s"),
+			expected: false,
+		},
+		"both null": {
+			value:    NewGoDurationNull(),
+			newValue: NewGoDurationNull(),
+			expected: true,
+		},
+		"invalid": {
+			value:    NewGoDurationValue("5 minutes"),
+			newValue: NewGoDurationValue("5m0s"),
+			expected: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, diags := tc.value.StringSemanticEquals(context.Background(), tc.newValue)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
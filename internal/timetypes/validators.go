@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// GoDurationAtLeast returns a validator which ensures that a configured
+// duration is at least min. Values that aren't valid durations are left to
+// the type's own validation.
+func GoDurationAtLeast(min time.Duration) validator.String {
+	return goDurationAtLeastValidator{min: min}
+}
+
+type goDurationAtLeastValidator struct {
+	min time.Duration
+}
+
+var _ validator.String = goDurationAtLeastValidator{}
+
+func (v goDurationAtLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("duration must be at least %s", v.min)
+}
+
+func (v goDurationAtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v goDurationAtLeastValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		return
+	}
+
+	if d < v.min {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration Value",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}